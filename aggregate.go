@@ -0,0 +1,96 @@
+package lnode
+
+import "cmp"
+
+/*
+Histogram returns how many nodes there are per key, where the key of a node is computed from its value by the key function. The chain is walked once, starting at n and going "to the right". Circular chains are handled like VisitByNext() does: every node is counted once. The map is empty, but not nil, when n is nil.
+
+Example:
+
+	// anchor: "apple" --- "avocado" --- "banana"
+	counts := lnode.Histogram(anchor, func(s string) byte { return s[0] })
+	// counts: map['a':2 'b':1]
+*/
+func Histogram[V any, K comparable](n *Node[V], key func(V) K) map[K]int {
+	counts := map[K]int{}
+	n.EachValue(func(v V) {
+		counts[key(v)]++
+	})
+	return counts
+}
+
+/*
+MaxBy returns the node whose key, computed from its value by the key function, is the largest. On ties, the first such node is returned. The chain is walked once, starting at n and going "to the right"; circular chains are handled like VisitByNext() does. When n is nil, nil is returned.
+
+Example:
+
+	// anchor: "fig" --- "banana" --- "kiwi"
+	longest := lnode.MaxBy(anchor, func(s string) int { return len(s) })
+	fmt.Println(longest.Value)
+	// Output: banana
+*/
+func MaxBy[V any, K cmp.Ordered](n *Node[V], key func(V) K) *Node[V] {
+	return extremeBy(n, key, func(a, b K) bool { return cmp.Less(b, a) })
+}
+
+/*
+MinBy is the counterpart of MaxBy(): it returns the node whose key is the smallest, or the first such node on ties. Example:
+
+	// anchor: "fig" --- "banana" --- "kiwi"
+	shortest := lnode.MinBy(anchor, func(s string) int { return len(s) })
+	fmt.Println(shortest.Value)
+	// Output: fig
+*/
+func MinBy[V any, K cmp.Ordered](n *Node[V], key func(V) K) *Node[V] {
+	return extremeBy(n, key, cmp.Less[K])
+}
+
+// extremeBy returns the first node whose key beats all others, where better(a, b) tells whether key a beats key b.
+func extremeBy[V any, K cmp.Ordered](n *Node[V], key func(V) K, better func(a, b K) bool) *Node[V] {
+	var best *Node[V]
+	var bestKey K
+	n.Each(func(node *Node[V]) {
+		if k := key(node.Value); best == nil || better(k, bestKey) {
+			best, bestKey = node, k
+		}
+	})
+	return best
+}
+
+/*
+Zip2Reduce walks chains a and b in lockstep, starting at the given nodes and going "to the right", and folds each pair of values into an accumulator that starts at init. The walk stops as soon as either chain ends, so that the number of folded pairs equals the length of the shorter chain; the remaining values of the longer chain are ignored. A circular chain ends before revisiting its start, like VisitByNext() does. The final accumulator is returned.
+
+Example:
+
+	// a: 1 --- 2 --- 3
+	// b: 4 --- 5 --- 6
+	dot := lnode.Zip2Reduce(a, b, 0, func(acc, x, y int) int { return acc + x*y })
+	fmt.Println(dot)
+	// Output: 32
+*/
+func Zip2Reduce[A any, B any, R any](a *Node[A], b *Node[B], init R, fn func(R, A, B) R) R {
+	acc := init
+	for na, nb := a, b; na != nil && nb != nil; na, nb = na.advance(a), nb.advance(b) {
+		acc = fn(acc, na.Value, nb.Value)
+	}
+	return acc
+}
+
+/*
+VisitAccum is VisitByNext() with an accumulator: the callback receives a pointer to a caller-owned accumulator along with each node, so that state is passed explicitly instead of being captured by a closure. Like VisitByNext(), the walk stops when the callback returns false, and circular chains stop before revisiting a node. Example:
+
+	type tally struct {
+		count, sum int
+	}
+	var acc tally
+	lnode.VisitAccum(anchor, &acc, func(acc *tally, node *lnode.Node[int]) bool {
+		acc.count++
+		acc.sum += node.Value
+		return true
+	})
+*/
+func VisitAccum[V any, A any](n *Node[V], acc *A, fn func(*A, *Node[V]) bool) {
+	n.VisitByNext(func(node *Node[V]) bool {
+		return fn(acc, node)
+	})
+}
@@ -0,0 +1,119 @@
+package lnode
+
+import (
+	"maps"
+	"strings"
+	"testing"
+)
+
+func TestHistogram(t *testing.T) {
+	nodes := chainOf("apple", "avocado", "banana", "cherry", "blueberry")
+	first := func(s string) byte { return s[0] }
+
+	want := map[byte]int{'a': 2, 'b': 2, 'c': 1}
+	if got := Histogram(nodes[0], first); !maps.Equal(got, want) {
+		t.Errorf("Histogram: got %v, want %v", got, want)
+	}
+
+	closeLoop(nodes)
+	if got := Histogram(nodes[3], first); !maps.Equal(got, want) {
+		t.Errorf("Histogram of circular chain: got %v, want %v", got, want)
+	}
+
+	if got := Histogram[string](nil, first); got == nil || len(got) != 0 {
+		t.Errorf("Histogram(nil) = %v, want empty map", got)
+	}
+}
+
+func TestMaxByMinBy(t *testing.T) {
+	length := func(s string) int { return len(s) }
+	nodes := chainOf("fig", "banana", "kiwi", "cherry", "pea")
+
+	if got := MaxBy(nodes[0], length); got != nodes[1] {
+		t.Errorf("MaxBy = %v, want %v (first of ties)", got, nodes[1])
+	}
+	if got := MinBy(nodes[0], length); got != nodes[0] {
+		t.Errorf("MinBy = %v, want %v (first of ties)", got, nodes[0])
+	}
+	if got := MinBy(nodes[1], length); got != nodes[4] {
+		t.Errorf("MinBy from the middle = %v, want %v", got, nodes[4])
+	}
+	if got := MaxBy[string](nil, length); got != nil {
+		t.Errorf("MaxBy(nil) = %v, want nil", got)
+	}
+
+	closeLoop(nodes)
+	if got := MaxBy(nodes[2], length); got != nodes[3] {
+		t.Errorf("MaxBy of circular chain = %v, want %v", got, nodes[3])
+	}
+}
+
+func TestZip2Reduce(t *testing.T) {
+	dot := func(acc, x, y int) int { return acc + x*y }
+	for _, test := range []struct {
+		a, b []int
+		want int
+	}{
+		{a: nil, b: []int{1}, want: 100},
+		{a: []int{1, 2, 3}, b: []int{4, 5, 6}, want: 132},
+		{a: []int{1, 2, 3}, b: []int{4}, want: 104},
+		{a: []int{1}, b: []int{4, 5, 6}, want: 104},
+	} {
+		var a, b *Node[int]
+		if len(test.a) > 0 {
+			a = chainOf(test.a...)[0]
+		}
+		if len(test.b) > 0 {
+			b = chainOf(test.b...)[0]
+		}
+		if got := Zip2Reduce(a, b, 100, dot); got != test.want {
+			t.Errorf("Zip2Reduce(%v, %v) = %d, want %d", test.a, test.b, got, test.want)
+		}
+	}
+
+	ring := chainOf("a", "b")
+	closeLoop(ring)
+	got := Zip2Reduce(ring[0], chainOf(1, 2, 3, 4)[0], "", func(acc, s string, n int) string {
+		return acc + strings.Repeat(s, n)
+	})
+	if want := "abb"; got != want {
+		t.Errorf("Zip2Reduce with circular chain = %q, want %q", got, want)
+	}
+}
+
+func TestVisitAccum(t *testing.T) {
+	type tally struct {
+		count, sum int
+	}
+	nodes := chainOf(1, 2, 3, 4)
+
+	var acc tally
+	VisitAccum(nodes[0], &acc, func(acc *tally, node *Node[int]) bool {
+		acc.count++
+		acc.sum += node.Value
+		return true
+	})
+	if want := (tally{4, 10}); acc != want {
+		t.Errorf("VisitAccum = %+v, want %+v", acc, want)
+	}
+
+	acc = tally{}
+	VisitAccum(nodes[0], &acc, func(acc *tally, node *Node[int]) bool {
+		acc.count++
+		acc.sum += node.Value
+		return acc.sum < 3
+	})
+	if want := (tally{2, 3}); acc != want {
+		t.Errorf("VisitAccum with early stop = %+v, want %+v", acc, want)
+	}
+
+	closeLoop(nodes)
+	acc = tally{}
+	VisitAccum(nodes[2], &acc, func(acc *tally, node *Node[int]) bool {
+		acc.count++
+		return true
+	})
+	if acc.count != 4 {
+		t.Errorf("VisitAccum on circular chain visited %d nodes, want 4", acc.count)
+	}
+}
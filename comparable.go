@@ -0,0 +1,237 @@
+package lnode
+
+import (
+	"fmt"
+	"hash/maphash"
+)
+
+// hashSeed is used by HashValues(), so that hashes are comparable within one run of the program.
+var hashSeed = maphash.MakeSeed()
+
+// FNV-1a parameters, used by HashValues() to combine the hashes of values.
+const (
+	fnvOffset64 = 14695981039346656037
+	fnvPrime64  = 1099511628211
+)
+
+/*
+IsPalindrome returns true when the chain that the node is part of reads the same from head to tail as from tail to head. The check converges from both ends using the Next and Prev pointers, so it runs in O(N) time without extra storage. A nil node or a single node is a palindrome. A circular chain has no ends and is never a palindrome.
+
+Example:
+
+	anchor := lnode.New[string]("a")
+	anchor.Append(New[string]("b"))
+	anchor.Next.Append(New[string]("a"))
+	// Structure:
+	// a --- b --- a
+	// ^anchor
+
+	fmt.Println(lnode.IsPalindrome(anchor))
+	// Output: true
+*/
+func IsPalindrome[V comparable](n *Node[V]) bool {
+	if n == nil {
+		return true
+	}
+	left, right := n.Head(), n.Tail()
+	if left == nil || right == nil {
+		return false
+	}
+	for left != right && left.Prev != right {
+		if left.Value != right.Value {
+			return false
+		}
+		left = left.Next
+		right = right.Prev
+	}
+	return true
+}
+
+/*
+CountOccurrences returns the number of nodes, starting at n and going "to the right", that hold a value equal to target. Circular chains are handled like VisitByNext() does: every node is visited once. Example:
+
+	anchor := lnode.New[int](1)
+	anchor.Append(New[int](2))
+	anchor.Next.Append(New[int](1))
+
+	fmt.Println(lnode.CountOccurrences(anchor, 1))
+	// Output: 2
+*/
+func CountOccurrences[V comparable](n *Node[V], target V) int {
+	count := 0
+	n.VisitByNext(func(node *Node[V]) bool {
+		if node.Value == target {
+			count++
+		}
+		return true
+	})
+	return count
+}
+
+/*
+ValuesEqual returns true when the values of the chain, starting at n and going "to the right", equal want, in order and length. This turns assertions in tests into one-liners. The walk stops as soon as a value differs or the chain turns out to be longer than want. Circular chains are handled like VisitByNext() does: every node is visited once. A nil node equals an empty slice. Example:
+
+	// anchor: 1 --- 2 --- 3
+	fmt.Println(lnode.ValuesEqual(anchor, []int{1, 2, 3}))
+	// Output: true
+*/
+func ValuesEqual[V comparable](n *Node[V], want []V) bool {
+	i := 0
+	n.VisitByNext(func(node *Node[V]) bool {
+		if i >= len(want) || node.Value != want[i] {
+			i = -1
+			return false
+		}
+		i++
+		return true
+	})
+	return i == len(want)
+}
+
+/*
+HashValues returns an order-sensitive hash of the values of the chain, starting at n and going "to the right". Every value is hashed using hash/maphash, and the hashes are combined FNV-1a style. This is a quick way to tell whether two large chains differ, or to derive a cache key. Differing hashes guarantee that the values differ; equal hashes make it very likely that the values are equal, but don't guarantee it, as different chains can collide. The hashes are only comparable within one run of the program; they shouldn't be stored. Circular chains are handled like VisitByNext() does: every node is hashed once. Example:
+
+	// a: 1 --- 2 --- 3
+	// b: 3 --- 2 --- 1
+	fmt.Println(lnode.HashValues(a) == lnode.HashValues(b))
+	// Output: false
+*/
+func HashValues[V comparable](n *Node[V]) uint64 {
+	var h uint64 = fnvOffset64
+	n.EachValue(func(v V) {
+		h ^= maphash.Comparable(hashSeed, v)
+		h *= fnvPrime64
+	})
+	return h
+}
+
+/*
+RotateToValue is RotateUntil() for a specific value: it searches the chain that the node is part of, from its head, for the first node holding target. The chain is rotated so that this node becomes the new head: the nodes before it are moved, in order, behind the tail. The new head is returned. When target isn't present, an error wrapping ErrNotFound is returned and the chain is left untouched.
+
+A circular chain is not relinked: any node can serve as its start. The first node holding target, searching rightward from n, is returned.
+
+Example:
+
+	anchor := lnode.New[int](0)
+	anchor.Append(New[int](1))
+	anchor.Next.Append(New[int](2))
+	anchor.Next.Next.Append(New[int](3))
+	// Structure:
+	// 0 --- 1 --- 2 --- 3
+	// ^anchor
+
+	head, err := lnode.RotateToValue(anchor, 2)
+	// New structure:
+	// 2 --- 3 --- 0 --- 1
+	// ^head       ^anchor
+*/
+func RotateToValue[V comparable](n *Node[V], target V) (*Node[V], error) {
+	head, err := n.RotateUntil(func(v V) bool { return v == target })
+	if err != nil {
+		return nil, fmt.Errorf("%w: value %v", ErrNotFound, target)
+	}
+	return head, nil
+}
+
+/*
+CountDistinct returns the number of distinct values in the chain, starting at n and going "to the right". A nil node has no values, so 0 is returned. Circular chains are handled like VisitByNext() does: every node is counted once. Example:
+
+	// anchor: 1 --- 2 --- 1 --- 3
+	fmt.Println(lnode.CountDistinct(anchor))
+	// Output: 3
+*/
+func CountDistinct[V comparable](n *Node[V]) int {
+	return len(valueSet(n))
+}
+
+/*
+EqualRing returns true when a and b are circular chains (see RingLen()) that hold the same values in the same cyclic order, regardless of which node each starts at; i.e., when one ring is a rotation of the other. This is the natural equality for ring buffers, where the anchor is arbitrary. Both must be circular for EqualRing to return true.
+
+The values of a are searched for in the values of b, taken twice in a row, using the Knuth-Morris-Pratt algorithm. This takes O(N) time and space.
+
+Example:
+
+	// a: ring of 1 --- 2 --- 3
+	// b: ring of 3 --- 1 --- 2
+	// c: ring of 3 --- 2 --- 1
+	fmt.Println(lnode.EqualRing(a, b), lnode.EqualRing(a, c))
+	// Output: true false
+*/
+func EqualRing[V comparable](a, b *Node[V]) bool {
+	if a.RingLen() == 0 || b.RingLen() == 0 {
+		return false
+	}
+	pattern, text := a.ToSlice(), b.ToSlice()
+	if len(pattern) != len(text) {
+		return false
+	}
+	text = append(text, text[:len(text)-1]...)
+
+	// failure[i] is the length of the longest proper prefix of pattern[:i+1] that is also its suffix.
+	failure := make([]int, len(pattern))
+	for i, k := 1, 0; i < len(pattern); i++ {
+		for k > 0 && pattern[i] != pattern[k] {
+			k = failure[k-1]
+		}
+		if pattern[i] == pattern[k] {
+			k++
+		}
+		failure[i] = k
+	}
+
+	for i, k := 0, 0; i < len(text); i++ {
+		for k > 0 && text[i] != pattern[k] {
+			k = failure[k-1]
+		}
+		if text[i] == pattern[k] {
+			k++
+		}
+		if k == len(pattern) {
+			return true
+		}
+	}
+	return false
+}
+
+// Run is a value together with the number of times that it occurs consecutively, as produced by RunLengthEncode().
+type Run[V any] struct {
+	Value V   // Repeated value
+	Count int // Number of consecutive occurrences
+}
+
+/*
+RunLengthEncode collapses every run of consecutive equal values of the chain, starting at n and going "to the right", into a Run holding the value and its count. The order is preserved. When n is nil, nil is returned. Circular chains are handled like VisitByNext() does: every node is visited once, and a run doesn't wrap around from the last node to n. Example:
+
+	// anchor: a --- a --- b --- a
+	fmt.Println(lnode.RunLengthEncode(anchor))
+	// Output: [{a 2} {b 1} {a 1}]
+*/
+func RunLengthEncode[V comparable](n *Node[V]) []Run[V] {
+	var runs []Run[V]
+	n.EachValue(func(v V) {
+		if last := len(runs) - 1; last >= 0 && runs[last].Value == v {
+			runs[last].Count++
+			return
+		}
+		runs = append(runs, Run[V]{Value: v, Count: 1})
+	})
+	return runs
+}
+
+/*
+RunLengthDecode is the counterpart of RunLengthEncode(): it returns a new chain in which every run is expanded into Count nodes holding Value. Runs with a Count of zero or less add no nodes. When no nodes result, nil is returned. Example:
+
+	head := lnode.RunLengthDecode([]lnode.Run[string]{{"a", 2}, {"b", 1}})
+	// Structure:
+	// a --- a --- b
+	// ^head
+*/
+func RunLengthDecode[V any](runs []Run[V]) *Node[V] {
+	var b builder[V]
+	for _, run := range runs {
+		for range run.Count {
+			b.add(run.Value)
+		}
+	}
+	return b.head
+}
@@ -0,0 +1,239 @@
+package lnode
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestIsPalindrome(t *testing.T) {
+	if !IsPalindrome[int](nil) {
+		t.Errorf("IsPalindrome(nil) = false, want true")
+	}
+	for _, test := range []struct {
+		values []int
+		want   bool
+	}{
+		{values: []int{1}, want: true},
+		{values: []int{1, 1}, want: true},
+		{values: []int{1, 2}, want: false},
+		{values: []int{1, 2, 1}, want: true},
+		{values: []int{1, 2, 2, 1}, want: true},
+		{values: []int{1, 2, 3, 1}, want: false},
+		{values: []int{1, 2, 3, 2, 1}, want: true},
+		{values: []int{1, 2, 3, 2, 2}, want: false},
+	} {
+		nodes := chainOf(test.values...)
+		// Start from a node in the middle, the whole chain is checked.
+		if got := IsPalindrome(nodes[len(nodes)/2]); got != test.want {
+			t.Errorf("IsPalindrome(%v) = %v, want %v", test.values, got, test.want)
+		}
+	}
+
+	nodes := chainOf(1, 2, 1)
+	closeLoop(nodes)
+	if IsPalindrome(nodes[0]) {
+		t.Errorf("IsPalindrome(circular chain) = true, want false")
+	}
+}
+
+func TestCountOccurrences(t *testing.T) {
+	if got := CountOccurrences[int](nil, 1); got != 0 {
+		t.Errorf("CountOccurrences(nil, 1) = %d, want 0", got)
+	}
+	nodes := chainOf(1, 2, 1, 3, 1)
+	for _, test := range []struct {
+		target int
+		want   int
+	}{
+		{target: 1, want: 3},
+		{target: 2, want: 1},
+		{target: 4, want: 0},
+	} {
+		if got := CountOccurrences(nodes[0], test.target); got != test.want {
+			t.Errorf("CountOccurrences(%d) = %d, want %d", test.target, got, test.want)
+		}
+	}
+	if got := CountOccurrences(nodes[2], 1); got != 2 {
+		t.Errorf("CountOccurrences from the middle = %d, want 2", got)
+	}
+
+	closeLoop(nodes)
+	if got := CountOccurrences(nodes[2], 1); got != 3 {
+		t.Errorf("CountOccurrences in circular chain = %d, want 3", got)
+	}
+}
+
+func TestValuesEqual(t *testing.T) {
+	nodes := chainOf(1, 2, 3)
+	for _, test := range []struct {
+		want []int
+		ok   bool
+	}{
+		{want: []int{1, 2, 3}, ok: true},
+		{want: []int{1, 2}, ok: false},
+		{want: []int{1, 2, 3, 4}, ok: false},
+		{want: []int{1, 5, 3}, ok: false},
+		{want: nil, ok: false},
+	} {
+		if got := ValuesEqual(nodes[0], test.want); got != test.ok {
+			t.Errorf("ValuesEqual(%v) = %v, want %v", test.want, got, test.ok)
+		}
+	}
+
+	var empty *Node[int]
+	if !ValuesEqual(empty, nil) || ValuesEqual(empty, []int{1}) {
+		t.Errorf("ValuesEqual: nil node must equal only an empty slice")
+	}
+
+	closeLoop(nodes)
+	if !ValuesEqual(nodes[1], []int{2, 3, 1}) || ValuesEqual(nodes[1], []int{2, 3, 1, 2}) {
+		t.Errorf("ValuesEqual: circular chain must be compared once around")
+	}
+}
+
+func TestHashValues(t *testing.T) {
+	a, b := chainOf(1, 2, 3), chainOf(1, 2, 3)
+	if HashValues(a[0]) != HashValues(b[0]) {
+		t.Errorf("HashValues: equal chains hash differently")
+	}
+	var empty *Node[int]
+	for _, other := range []*Node[int]{chainOf(3, 2, 1)[0], chainOf(1, 2)[0], chainOf(1, 2, 3, 0)[0], a[1], empty} {
+		if HashValues(a[0]) == HashValues(other) {
+			t.Errorf("HashValues: %v hashes like %v", valuesOf(other), valuesOf(a[0]))
+		}
+	}
+	closeLoop(a)
+	if HashValues(a[0]) != HashValues(b[0]) {
+		t.Errorf("HashValues: circular chain must be hashed once around")
+	}
+}
+
+func TestRotateToValue(t *testing.T) {
+	for _, test := range []struct {
+		target int
+		want   []int
+	}{
+		{target: 0, want: []int{0, 1, 2, 3}},
+		{target: 2, want: []int{2, 3, 0, 1}},
+		{target: 3, want: []int{3, 0, 1, 2}},
+	} {
+		nodes := chainOf(0, 1, 2, 3)
+		head, err := RotateToValue(nodes[1], test.target)
+		if err != nil {
+			t.Errorf("RotateToValue(%d): unexpected error %v", test.target, err)
+			continue
+		}
+		if head.Prev != nil {
+			t.Errorf("RotateToValue(%d): returned node is not the head", test.target)
+		}
+		if got := valuesOf(head); !slices.Equal(got, test.want) {
+			t.Errorf("RotateToValue(%d): got %v, want %v", test.target, got, test.want)
+		}
+		if !wellLinked(head) || head.Circular() {
+			t.Errorf("RotateToValue(%d): chain is malformed", test.target)
+		}
+	}
+
+	nodes := chainOf(0, 1, 2, 3)
+	if _, err := RotateToValue(nodes[0], 5); !errors.Is(err, ErrNotFound) {
+		t.Errorf("RotateToValue(5) error = %v, want ErrNotFound", err)
+	}
+	if got, want := valuesOf(nodes[0]), []int{0, 1, 2, 3}; !slices.Equal(got, want) {
+		t.Errorf("RotateToValue(5): chain changed to %v, want %v", got, want)
+	}
+
+	closeLoop(nodes)
+	head, err := RotateToValue(nodes[3], 1)
+	if err != nil || head != nodes[1] || !nodes[0].Circular() {
+		t.Errorf("RotateToValue on circular chain = %v, %v; want %v and an intact ring", head, err, nodes[1])
+	}
+}
+
+func TestCountDistinct(t *testing.T) {
+	if got := CountDistinct[int](nil); got != 0 {
+		t.Errorf("CountDistinct(nil) = %d, want 0", got)
+	}
+	nodes := chainOf(1, 2, 1, 3, 3, 1)
+	if got := CountDistinct(nodes[0]); got != 3 {
+		t.Errorf("CountDistinct(%v) = %d, want 3", valuesOf(nodes[0]), got)
+	}
+	if got := CountDistinct(nodes[4]); got != 2 {
+		t.Errorf("CountDistinct from the middle = %d, want 2", got)
+	}
+	closeLoop(nodes)
+	if got := CountDistinct(nodes[4]); got != 3 {
+		t.Errorf("CountDistinct of circular chain = %d, want 3", got)
+	}
+}
+
+func TestEqualRing(t *testing.T) {
+	ring := func(values ...int) *Node[int] {
+		nodes := chainOf(values...)
+		closeLoop(nodes)
+		return nodes[0]
+	}
+	for _, test := range []struct {
+		a, b []int
+		want bool
+	}{
+		{a: []int{1}, b: []int{1}, want: true},
+		{a: []int{1}, b: []int{2}, want: false},
+		{a: []int{1, 2, 3}, b: []int{1, 2, 3}, want: true},
+		{a: []int{1, 2, 3}, b: []int{3, 1, 2}, want: true},
+		{a: []int{1, 2, 3}, b: []int{2, 3, 1}, want: true},
+		{a: []int{1, 2, 3}, b: []int{3, 2, 1}, want: false},
+		{a: []int{1, 2, 3}, b: []int{1, 2, 3, 1}, want: false},
+		{a: []int{1, 1, 2, 1, 1, 3}, b: []int{1, 3, 1, 1, 2, 1}, want: true},
+		{a: []int{1, 1, 2, 1, 1, 3}, b: []int{1, 3, 1, 2, 1, 1}, want: false},
+	} {
+		if got := EqualRing(ring(test.a...), ring(test.b...)); got != test.want {
+			t.Errorf("EqualRing(%v, %v) = %v, want %v", test.a, test.b, got, test.want)
+		}
+	}
+
+	if EqualRing(chainOf(1, 2)[0], chainOf(1, 2)[0]) {
+		t.Errorf("EqualRing of linear chains = true, want false")
+	}
+	if EqualRing(ring(1, 2), chainOf(1, 2)[0]) {
+		t.Errorf("EqualRing of circular and linear chain = true, want false")
+	}
+	if EqualRing[int](nil, nil) {
+		t.Errorf("EqualRing(nil, nil) = true, want false")
+	}
+}
+
+func TestRunLength(t *testing.T) {
+	for _, test := range []struct {
+		values []string
+		runs   []Run[string]
+	}{
+		{values: nil, runs: nil},
+		{values: []string{"a"}, runs: []Run[string]{{"a", 1}}},
+		{values: []string{"a", "a", "b", "a"}, runs: []Run[string]{{"a", 2}, {"b", 1}, {"a", 1}}},
+		{values: []string{"a", "b", "b", "b"}, runs: []Run[string]{{"a", 1}, {"b", 3}}},
+	} {
+		var n *Node[string]
+		if len(test.values) > 0 {
+			n = chainOf(test.values...)[0]
+		}
+		runs := RunLengthEncode(n)
+		if !slices.Equal(runs, test.runs) {
+			t.Errorf("RunLengthEncode(%v) = %v, want %v", test.values, runs, test.runs)
+		}
+		decoded := RunLengthDecode(runs)
+		if got := valuesOf(decoded); !slices.Equal(got, test.values) || !wellLinked(decoded) {
+			t.Errorf("RunLengthDecode(%v) = %v, want %v", runs, got, test.values)
+		}
+	}
+
+	if got := RunLengthDecode([]Run[int]{{1, 0}, {2, -1}}); got != nil {
+		t.Errorf("RunLengthDecode of empty runs = %v, want nil", valuesOf(got))
+	}
+
+	nodes := chainOf(1, 2, 1)
+	closeLoop(nodes)
+	if got, want := RunLengthEncode(nodes[0]), []Run[int]{{1, 1}, {2, 1}, {1, 1}}; !slices.Equal(got, want) {
+		t.Errorf("RunLengthEncode of circular chain = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,209 @@
+package lnode
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+)
+
+/*
+Join formats the values of the node and all next nodes ("to the right") using fmt, and joins them with sep. This is the linked-list counterpart of strings.Join. Circular chains are handled like VisitByNext() does: every node is visited once. Example:
+
+	anchor := lnode.New[int](1)
+	anchor.Append(New[int](2))
+	anchor.Next.Append(New[int](3))
+
+	fmt.Println(lnode.Join(anchor, ", ", strconv.Itoa))
+	// Output: 1, 2, 3
+*/
+func Join[V any](n *Node[V], sep string, fmt func(V) string) string {
+	var sb strings.Builder
+	n.VisitByNext(func(node *Node[V]) bool {
+		if node != n {
+			sb.WriteString(sep)
+		}
+		sb.WriteString(fmt(node.Value))
+		return true
+	})
+	return sb.String()
+}
+
+/*
+ToSlice returns the values of the node and all next nodes ("to the right"), in order. Circular chains are handled like VisitByNext() does: every node is included once. When n is nil, nil is returned. Example:
+
+	// anchor: 0 --- 1 --- 2
+	fmt.Println(anchor.ToSlice())
+	// Output: [0 1 2]
+*/
+func (n *Node[V]) ToSlice() []V {
+	var out []V
+	n.EachValue(func(v V) {
+		out = append(out, v)
+	})
+	return out
+}
+
+/*
+ToSliceReverse is the mirror of ToSlice(): it returns the values of the node and all previous nodes ("to the left"), in that order. This is convenient when holding the tail of a chain. Circular chains are handled like VisitByPrev() does: every node is included once. Example:
+
+	// anchor: 0 --- 1 --- 2
+	fmt.Println(anchor.Tail().ToSliceReverse())
+	// Output: [2 1 0]
+*/
+func (n *Node[V]) ToSliceReverse() []V {
+	var out []V
+	n.VisitByPrev(func(node *Node[V]) bool {
+		out = append(out, node.Value)
+		return true
+	})
+	return out
+}
+
+/*
+Reversed returns a new chain holding the values of the node and all next nodes ("to the right"), in reverse order. The original chain is left untouched. When n is nil, nil is returned. Circular chains are handled like VisitByNext() does: every node is copied once, and the result is linear. Example:
+
+	// anchor: 0 --- 1 --- 2
+	reversed := anchor.Reversed()
+	// Structure of the new chain:
+	// 2 --- 1 --- 0
+	// ^reversed
+*/
+func (n *Node[V]) Reversed() *Node[V] {
+	var head *Node[V]
+	n.EachValue(func(v V) {
+		node := New(v)
+		if head != nil {
+			head.Prepend(node)
+		}
+		head = node
+	})
+	return head
+}
+
+/*
+BuildChain returns a new chain holding values, in order, and returns both its head and its tail. Keeping the tail around allows further appends in O(1), whereas calling Tail().Append() for every value takes O(N^2) in total. For an empty slice, both are nil. Example:
+
+	head, tail := lnode.BuildChain([]int{0, 1, 2})
+	// Structure:
+	// 0 --- 1 --- 2
+	// ^head       ^tail
+
+	for i := 3; i < 10; i++ {
+		tail.Append(lnode.New[int](i))
+		tail = tail.Next
+	}
+*/
+func BuildChain[V any](values []V) (head, tail *Node[V]) {
+	var b builder[V]
+	for _, v := range values {
+		b.add(v)
+	}
+	return b.head, b.tail
+}
+
+/*
+Nodes returns pointers to the node and all next nodes ("to the right"), in order. Unlike ToSlice(), the nodes themselves are returned, so that specific ones can later be modified, moved or deleted. Circular chains are handled like VisitByNext() does: every node is included once. When n is nil, nil is returned. Example:
+
+	// anchor: 0 --- 1 --- 2
+	nodes := anchor.Nodes()
+	nodes[1].Delete()
+	// New structure:
+	// 0 --- 2
+*/
+func (n *Node[V]) Nodes() []*Node[V] {
+	var out []*Node[V]
+	n.Each(func(node *Node[V]) {
+		out = append(out, node)
+	})
+	return out
+}
+
+/*
+FromNodes relinks existing nodes into a new chain, in the order of the slice, and returns its head. This is the counterpart of Nodes(): the slice can be reordered, e.g. sorted, and turned back into a chain without allocating nodes. All Next and Prev pointers of the nodes are rewritten, so their previous links don't matter; however, nodes that aren't in the slice may still point to them. Nil entries are skipped, and every node should occur at most once. When no nodes are given, nil is returned. Example:
+
+	// anchor: 2 --- 0 --- 1
+	nodes := anchor.Nodes()
+	slices.SortFunc(nodes, func(a, b *lnode.Node[int]) int { return a.Value - b.Value })
+	head := lnode.FromNodes(nodes)
+	// New structure:
+	// 0 --- 1 --- 2
+	// ^head       ^anchor
+*/
+func FromNodes[V any](nodes []*Node[V]) *Node[V] {
+	var b builder[V]
+	for _, node := range nodes {
+		if node != nil {
+			b.link(node)
+		}
+	}
+	return b.head
+}
+
+/*
+Unfold builds a new chain from a generator and returns its head. The first node holds seed; every next value is computed by next from the previous one, until next returns false. The value that next returns along with false is discarded. This is the counterpart of folding a chain into one value, and is useful to collect e.g. pages of results into a list.
+
+Unfold keeps going as long as next returns true, so next must eventually return false.
+
+Example:
+
+	powers := lnode.Unfold(1, func(v int) (int, bool) { return v * 2, v*2 <= 100 })
+	// Structure:
+	// 1 --- 2 --- 4 --- 8 --- 16 --- 32 --- 64
+	// ^powers
+*/
+func Unfold[V any](seed V, next func(V) (V, bool)) *Node[V] {
+	var b builder[V]
+	for v, ok := seed, true; ok; v, ok = next(v) {
+		b.add(v)
+	}
+	return b.head
+}
+
+/*
+WriteTo writes the values of the node and all next nodes ("to the right") to w, one per line: every value is formatted by fmt and followed by a newline. This dumps a chain to e.g. a file or a network connection without building one big string, as Join() does. The number of bytes written is returned. Writing stops at the first error, which is returned. Since it takes a formatter, WriteTo has the shape of io.WriterTo's method, but doesn't satisfy that interface. Circular chains are handled like VisitByNext() does: every value is written once. ReadFrom() reads the lines back. Example:
+
+	// anchor: 1 --- 2 --- 3
+	anchor.WriteTo(os.Stdout, strconv.Itoa)
+	// Output:
+	// 1
+	// 2
+	// 3
+*/
+func (n *Node[V]) WriteTo(w io.Writer, fmt func(V) string) (int64, error) {
+	var total int64
+	var err error
+	n.VisitByNext(func(node *Node[V]) bool {
+		var written int
+		written, err = io.WriteString(w, fmt(node.Value)+"\n")
+		total += int64(written)
+		return err == nil
+	})
+	return total, err
+}
+
+/*
+ReadFrom is the counterpart of WriteTo(): it reads r line by line, turns every line into a value using parse, and returns the head of a new chain holding the values in order. The lines are passed to parse without their line endings. For empty input, nil is returned. Reading stops at the first error of parse or of r, which is returned, wrapped with the number of the offending line; the chain of the values parsed until then is returned as well. Lines longer than bufio.MaxScanTokenSize can't be read and cause an error. Example:
+
+	head, err := lnode.ReadFrom(strings.NewReader("1\n2\n3\n"), strconv.Atoi)
+	// Structure:
+	// 1 --- 2 --- 3
+	// ^head
+*/
+func ReadFrom[V any](r io.Reader, parse func(string) (V, error)) (*Node[V], error) {
+	var b builder[V]
+	scanner := bufio.NewScanner(r)
+	line := 0
+	for scanner.Scan() {
+		line++
+		v, err := parse(scanner.Text())
+		if err != nil {
+			return b.head, fmt.Errorf("line %d: %w", line, err)
+		}
+		b.add(v)
+	}
+	if err := scanner.Err(); err != nil {
+		return b.head, fmt.Errorf("line %d: %w", line+1, err)
+	}
+	return b.head, nil
+}
@@ -0,0 +1,253 @@
+package lnode
+
+import (
+	"errors"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestJoin(t *testing.T) {
+	for _, test := range []struct {
+		desc     string
+		values   []int
+		circular bool
+		want     string
+	}{
+		{desc: "empty", want: ""},
+		{desc: "single", values: []int{1}, want: "1"},
+		{desc: "several", values: []int{1, 2, 3}, want: "1, 2, 3"},
+		{desc: "circular", values: []int{1, 2, 3}, circular: true, want: "1, 2, 3"},
+	} {
+		var anchor *Node[int]
+		if len(test.values) > 0 {
+			nodes := chainOf(test.values...)
+			if test.circular {
+				closeLoop(nodes)
+			}
+			anchor = nodes[0]
+		}
+		if got := Join(anchor, ", ", strconv.Itoa); got != test.want {
+			t.Errorf("Join: %s: got %q, want %q", test.desc, got, test.want)
+		}
+	}
+}
+
+func TestToSlice(t *testing.T) {
+	nodes := chainOf(0, 1, 2, 3)
+	for _, test := range []struct {
+		desc string
+		got  []int
+		want []int
+	}{
+		{desc: "ToSlice from head", got: nodes[0].ToSlice(), want: []int{0, 1, 2, 3}},
+		{desc: "ToSlice from middle", got: nodes[2].ToSlice(), want: []int{2, 3}},
+		{desc: "ToSliceReverse from tail", got: nodes[3].ToSliceReverse(), want: []int{3, 2, 1, 0}},
+		{desc: "ToSliceReverse from middle", got: nodes[1].ToSliceReverse(), want: []int{1, 0}},
+	} {
+		if !slices.Equal(test.got, test.want) {
+			t.Errorf("%s: got %v, want %v", test.desc, test.got, test.want)
+		}
+	}
+
+	var empty *Node[int]
+	if got := empty.ToSliceReverse(); got != nil {
+		t.Errorf("ToSliceReverse of nil node = %v, want nil", got)
+	}
+
+	closeLoop(nodes)
+	if got, want := nodes[1].ToSliceReverse(), []int{1, 0, 3, 2}; !slices.Equal(got, want) {
+		t.Errorf("ToSliceReverse of circular chain: got %v, want %v", got, want)
+	}
+	if got, want := nodes[1].ToSlice(), []int{1, 2, 3, 0}; !slices.Equal(got, want) {
+		t.Errorf("ToSlice of circular chain: got %v, want %v", got, want)
+	}
+}
+
+func TestReversed(t *testing.T) {
+	var empty *Node[int]
+	if got := empty.Reversed(); got != nil {
+		t.Errorf("Reversed of nil node = %v, want nil", got)
+	}
+	nodes := chainOf(0, 1, 2)
+	got := nodes[0].Reversed()
+	if vals := valuesOf(got); !slices.Equal(vals, []int{2, 1, 0}) || !wellLinked(got) || got.Prev != nil {
+		t.Errorf("Reversed: got %v, want head of [2 1 0]", vals)
+	}
+	if vals := valuesOf(nodes[0]); !slices.Equal(vals, []int{0, 1, 2}) {
+		t.Errorf("Reversed: original changed to %v", vals)
+	}
+
+	closeLoop(nodes)
+	got = nodes[1].Reversed()
+	if vals := valuesOf(got); !slices.Equal(vals, []int{0, 2, 1}) || got.Tail() == nil {
+		t.Errorf("Reversed of circular chain: got %v, want linear [0 2 1]", vals)
+	}
+}
+
+func TestBuildChain(t *testing.T) {
+	head, tail := BuildChain([]string{"a", "b", "c"})
+	if got, want := valuesOf(head), []string{"a", "b", "c"}; !slices.Equal(got, want) || !wellLinked(head) {
+		t.Errorf("BuildChain: got %v, want %v", got, want)
+	}
+	if head.Prev != nil || tail != head.Tail() {
+		t.Errorf("BuildChain: returned head %v and tail %v are not the ends", head, tail)
+	}
+
+	head, tail = BuildChain([]string{"a"})
+	if head == nil || head != tail {
+		t.Errorf("BuildChain of one value: head %v, tail %v; want the same node", head, tail)
+	}
+	head, tail = BuildChain[string](nil)
+	if head != nil || tail != nil {
+		t.Errorf("BuildChain(nil) = %v, %v; want nil, nil", head, tail)
+	}
+}
+
+func benchmarkValues() []int {
+	values := make([]int, 1000)
+	for i := range values {
+		values[i] = i
+	}
+	return values
+}
+
+func BenchmarkBuildChain(b *testing.B) {
+	values := benchmarkValues()
+	for b.Loop() {
+		BuildChain(values)
+	}
+}
+
+func BenchmarkBuildViaTail(b *testing.B) {
+	values := benchmarkValues()
+	for b.Loop() {
+		var root *Node[int]
+		for _, v := range values {
+			n := New[int](v)
+			if root == nil {
+				root = n
+			} else {
+				root.Tail().Append(n)
+			}
+		}
+	}
+}
+
+func TestNodes(t *testing.T) {
+	nodes := chainOf(0, 1, 2)
+	if got := nodes[0].Nodes(); !slices.Equal(got, nodes) {
+		t.Errorf("Nodes() = %v, want %v", got, nodes)
+	}
+	single := New[int](0)
+	if got := single.Nodes(); len(got) != 1 || got[0] != single {
+		t.Errorf("Nodes() of single node = %v, want [%v]", got, single)
+	}
+	var empty *Node[int]
+	if got := empty.Nodes(); got != nil {
+		t.Errorf("Nodes() of nil node = %v, want nil", got)
+	}
+	closeLoop(nodes)
+	if got, want := nodes[1].Nodes(), []*Node[int]{nodes[1], nodes[2], nodes[0]}; !slices.Equal(got, want) {
+		t.Errorf("Nodes() of circular chain = %v, want %v", got, want)
+	}
+}
+
+func TestFromNodes(t *testing.T) {
+	nodes := chainOf(2, 0, 1)
+	closeLoop(nodes)
+	sorted := []*Node[int]{nodes[1], nil, nodes[2], nodes[0]}
+	head := FromNodes(sorted)
+	if got := valuesOf(head); head != nodes[1] || !slices.Equal(got, []int{0, 1, 2}) || head.Prev != nil || nodes[0].Next != nil || !wellLinked(head) {
+		t.Errorf("FromNodes: got %v, want linear [0 1 2] of the same nodes", got)
+	}
+	if got := FromNodes([]*Node[int]{}); got != nil {
+		t.Errorf("FromNodes of no nodes = %v, want nil", got)
+	}
+}
+
+func TestUnfold(t *testing.T) {
+	powers := Unfold(1, func(v int) (int, bool) { return v * 2, v*2 <= 100 })
+	if got, want := valuesOf(powers), []int{1, 2, 4, 8, 16, 32, 64}; !slices.Equal(got, want) || !wellLinked(powers) {
+		t.Errorf("Unfold powers of 2: got %v, want %v", got, want)
+	}
+
+	only := Unfold("seed", func(string) (string, bool) { return "never", false })
+	if got, want := valuesOf(only), []string{"seed"}; !slices.Equal(got, want) {
+		t.Errorf("Unfold with immediate stop: got %v, want %v", got, want)
+	}
+}
+
+// failingWriter accepts limit bytes, and fails on writes beyond that.
+type failingWriter struct {
+	limit int
+}
+
+func (w *failingWriter) Write(p []byte) (int, error) {
+	if len(p) > w.limit {
+		written := w.limit
+		w.limit = 0
+		return written, errors.New("writer is full")
+	}
+	w.limit -= len(p)
+	return len(p), nil
+}
+
+func TestWriteTo(t *testing.T) {
+	nodes := chainOf(1, 22, 3)
+	var sb strings.Builder
+	written, err := nodes[0].WriteTo(&sb, strconv.Itoa)
+	if want := "1\n22\n3\n"; err != nil || sb.String() != want || written != int64(len(want)) {
+		t.Errorf("WriteTo = %d, %v, wrote %q; want %d, nil, %q", written, err, sb.String(), len(want), want)
+	}
+
+	closeLoop(nodes)
+	sb.Reset()
+	if _, err := nodes[1].WriteTo(&sb, strconv.Itoa); err != nil || sb.String() != "22\n3\n1\n" {
+		t.Errorf("WriteTo of circular chain: wrote %q, %v; want each value once", sb.String(), err)
+	}
+
+	calls := 0
+	written, err = nodes[0].WriteTo(&failingWriter{limit: 3}, func(v int) string {
+		calls++
+		return strconv.Itoa(v)
+	})
+	if err == nil || written != 3 || calls != 2 {
+		t.Errorf("WriteTo with failing writer = %d, %v after %d values; want 3, error after 2 values", written, err, calls)
+	}
+
+	var empty *Node[int]
+	sb.Reset()
+	if written, err := empty.WriteTo(&sb, strconv.Itoa); written != 0 || err != nil || sb.Len() != 0 {
+		t.Errorf("WriteTo of nil node = %d, %v; want nothing written", written, err)
+	}
+}
+
+func TestReadFrom(t *testing.T) {
+	head, err := ReadFrom(strings.NewReader("1\n22\r\n3"), strconv.Atoi)
+	if got := valuesOf(head); err != nil || !slices.Equal(got, []int{1, 22, 3}) || !wellLinked(head) {
+		t.Errorf("ReadFrom = %v, %v; want [1 22 3], nil", got, err)
+	}
+
+	head, err = ReadFrom(strings.NewReader(""), strconv.Atoi)
+	if head != nil || err != nil {
+		t.Errorf("ReadFrom of empty input = %v, %v; want nil, nil", head, err)
+	}
+
+	head, err = ReadFrom(strings.NewReader("1\n2\nx\n4\n"), strconv.Atoi)
+	if got := valuesOf(head); !slices.Equal(got, []int{1, 2}) || !errors.Is(err, strconv.ErrSyntax) || !strings.Contains(err.Error(), "line 3") {
+		t.Errorf("ReadFrom with bad line = %v, %v; want [1 2] and a syntax error on line 3", got, err)
+	}
+
+	// Round trip
+	var sb strings.Builder
+	nodes := chainOf(5, 6, 7)
+	if _, err := nodes[0].WriteTo(&sb, strconv.Itoa); err != nil {
+		t.Fatalf("WriteTo: %v", err)
+	}
+	head, err = ReadFrom(strings.NewReader(sb.String()), strconv.Atoi)
+	if got := valuesOf(head); err != nil || !slices.Equal(got, []int{5, 6, 7}) {
+		t.Errorf("ReadFrom after WriteTo = %v, %v; want [5 6 7], nil", got, err)
+	}
+}
@@ -0,0 +1,252 @@
+package lnode
+
+// meetingPoint runs Floyd's cycle detection from n following Next: a slow pointer advances one node and a fast pointer two. It returns the node where both meet, which is inside the loop, or nil when the chain ends.
+func (n *Node[V]) meetingPoint() *Node[V] {
+	slow, fast := n, n
+	for fast != nil && fast.Next != nil {
+		slow = slow.Next
+		fast = fast.Next.Next
+		if slow == fast {
+			return slow
+		}
+	}
+	return nil
+}
+
+// cycleEntry returns the first node of the loop that the chain runs into when following Next from n, or nil when the chain ends. Following Floyd's algorithm, a pointer from n and one from the meeting point, advancing at the same pace, meet at the entry.
+func (n *Node[V]) cycleEntry() *Node[V] {
+	meet := n.meetingPoint()
+	if meet == nil {
+		return nil
+	}
+	a, b := n, meet
+	for a != b {
+		a = a.Next
+		b = b.Next
+	}
+	return a
+}
+
+/*
+CycleLength returns the number of nodes in the loop that the chain runs into when following Next from the node, or 0 when the chain ends. The loop may comprise all nodes (a circular chain, see function Circular()), or only a part: a chain can run into a loop further "to the right" ("rho-shaped"), which Circular() doesn't detect. The loop is found using Floyd's algorithm in O(N) time and O(1) space.
+
+Example:
+
+	anchor := lnode.New[int](0)
+	for i := 1; i < 5; i++ {
+		anchor.Tail().Append(lnode.New[int](i))
+	}
+	fmt.Println(anchor.CycleLength())
+	// Output: 0
+
+	anchor.Tail().Next = anchor.Next.Next
+	// Structure:
+	//             +-----------+
+	//             |           |
+	// 0 --- 1 --- 2 --- 3 --- 4
+	// ^anchor
+	fmt.Println(anchor.CycleLength())
+	// Output: 3
+*/
+func (n *Node[V]) CycleLength() int {
+	meet := n.meetingPoint()
+	if meet == nil {
+		return 0
+	}
+	length := 1
+	for node := meet.Next; node != meet; node = node.Next {
+		length++
+	}
+	return length
+}
+
+/*
+CycleNodes returns the nodes that form the loop that the chain runs into when following Next from the node, in traversal order and starting at the loop's entry. For a circular chain (see function Circular()) these are all nodes; for a rho-shaped chain (see CycleLength()) only the nodes of the loop. When the chain ends, an empty slice is returned. This is useful to inspect malformed structures.
+
+Example:
+
+	// Structure:
+	//             +-----------+
+	//             |           |
+	// 0 --- 1 --- 2 --- 3 --- 4
+	// ^anchor
+	for _, node := range anchor.CycleNodes() {
+		fmt.Println(node.Value)
+	}
+	// Output: 2, 3, 4 (on separate lines)
+*/
+func (n *Node[V]) CycleNodes() []*Node[V] {
+	entry := n.cycleEntry()
+	if entry == nil {
+		return []*Node[V]{}
+	}
+	out := []*Node[V]{entry}
+	for node := entry.Next; node != entry; node = node.Next {
+		out = append(out, node)
+	}
+	return out
+}
+
+/*
+RingLen returns the number of distinct nodes in the circular chain that the node is part of, i.e., the number of steps via Next that it takes to get back to the node. For a chain that isn't circular (see function Circular()), 0 is returned; this includes chains that run into a loop further "to the right", without the node being part of that loop. A single node whose Next and Prev point to itself is a ring of length 1.
+
+Example:
+
+	r := lnode.New[int](0)
+	r.Next, r.Prev = r, r
+	fmt.Println(r.RingLen())
+	// Output: 1
+
+	r.Append(lnode.New[int](1))
+	fmt.Println(r.RingLen())
+	// Output: 2
+*/
+func (n *Node[V]) RingLen() int {
+	meet := n.meetingPoint()
+	if meet == nil {
+		return 0
+	}
+	length, onRing := 1, meet == n
+	for node := meet.Next; node != meet; node = node.Next {
+		length++
+		onRing = onRing || node == n
+	}
+	if !onRing {
+		return 0
+	}
+	return length
+}
+
+/*
+Advance returns the node that is k steps away in a circular chain, without changing any pointers: via Next for positive k, via Prev for negative k, wrapping around the ring. This is a navigation helper for ring buffers and round-robin schedulers. When the node isn't part of a circular chain (see RingLen()), nil is returned.
+
+Verifying the ring takes O(N) steps, N being the number of nodes in the ring. Since k is reduced modulo N, the walk itself takes less than N steps, even for large k. The walk always follows Next: a negative k is taken as N+k steps forward, so that only the verified pointers are used.
+
+Example:
+
+	// A ring of 0 --- 1 --- 2 --- 3, closing back to 0
+	// ^anchor
+	fmt.Println(anchor.Advance(1).Value, anchor.Advance(6).Value, anchor.Advance(-1).Value)
+	// Output: 1 2 3
+*/
+func (n *Node[V]) Advance(k int) *Node[V] {
+	length := n.RingLen()
+	if length == 0 {
+		return nil
+	}
+	// Walk only via Next, which RingLen() verified; Prev may lead off a loop.
+	k = (k%length + length) % length
+	node := n
+	for ; k > 0; k-- {
+		node = node.Next
+	}
+	return node
+}
+
+/*
+BreakCycle makes a chain linear again when, following Next from the node, it runs into a loop: either because it's circular (see function Circular()), or because it loops back somewhere further "to the right". The loop is detected using Floyd's algorithm. The Next pointer of the node that closes the loop is set to nil, making it the tail. When the first node of the loop has a Prev pointer to that new tail, it's set to its real predecessor, or to nil when there is none. BreakCycle returns true when a loop was broken, false when the chain was already linear.
+
+Example:
+
+	// anchor: 0 --- 1 --- 2 --- 3, with 3 linking back to 1
+	fmt.Println(anchor.BreakCycle())
+	// Output: true
+	// New structure:
+	// 0 --- 1 --- 2 --- 3
+	// ^anchor
+*/
+func (n *Node[V]) BreakCycle() bool {
+	entry := n.cycleEntry()
+	if entry == nil {
+		return false
+	}
+	closer := entry
+	for closer.Next != entry {
+		closer = closer.Next
+	}
+	closer.Next = nil
+
+	if entry.Prev == closer {
+		entry.Prev = nil
+		for node := n; node != entry; node = node.Next {
+			if node.Next == entry {
+				entry.Prev = node
+			}
+		}
+	}
+	return true
+}
+
+/*
+Stats walks the chain once, starting at the node and following Next, and reports:
+
+  - length: the number of distinct nodes reached. For a circular chain this is the number of nodes in the ring.
+  - circular: whether the walk leads back to the node, like Circular() reports.
+  - wellFormed: whether the Prev pointers are consistent with the Next pointers, i.e., every reached node is the Prev of its Next, and the Prev of the node itself (if any) has the node as its Next. A chain that runs into a loop without returning to the node itself is never well-formed, since the node where the loop closes has two predecessors.
+
+This bundles the information for logging and health checks into one traversal. To stay safe on any shape of chain, the visited nodes are tracked in a set, so this takes O(N) memory. Example:
+
+	// anchor: 0 --- 1 --- 2
+	length, circular, wellFormed := anchor.Stats()
+	fmt.Println(length, circular, wellFormed)
+	// Output: 3 false true
+*/
+func (n *Node[V]) Stats() (length int, circular bool, wellFormed bool) {
+	if n == nil {
+		return 0, false, true
+	}
+	wellFormed = n.Prev == nil || n.Prev.Next == n
+	seen := map[*Node[V]]bool{}
+	for node := n; node != nil && !seen[node]; node = node.Next {
+		seen[node] = true
+		length++
+		if node.Next == nil {
+			break
+		}
+		if node.Next.Prev != node {
+			wellFormed = false
+		}
+		if node.Next == n {
+			circular = true
+		} else if seen[node.Next] {
+			wellFormed = false
+		}
+	}
+	return length, circular, wellFormed
+}
+
+/*
+ReverseCircular reverses the direction of a circular chain in place, by swapping the Next and Prev pointers of every node in the ring. Afterwards the chain is still circular, but VisitByNext() goes the other way around. When the node isn't part of a circular chain (see RingLen()), or when the Prev pointers don't lead around the same ring, nothing happens. The latter is the case for a loop that other nodes run into ("rho-shaped", see CycleLength()): the node where the loop closes has a Prev outside the loop. Example:
+
+	// A ring of 0 --- 1 --- 2 --- 3, closing back to 0
+	// ^anchor
+	anchor.ReverseCircular()
+	// Now a ring of 0 --- 3 --- 2 --- 1, closing back to 0
+*/
+func (n *Node[V]) ReverseCircular() {
+	length := n.RingLen()
+	if length == 0 {
+		return
+	}
+	// RingLen() only follows Next. Make sure that Prev leads around the same ring, so that swapping doesn't corrupt e.g. a loop that other nodes run into.
+	node := n
+	for range length {
+		if node.Prev == nil || node.Prev.Next != node {
+			return
+		}
+		node = node.Prev
+	}
+	if node != n {
+		return
+	}
+
+	node = n
+	for {
+		node.Next, node.Prev = node.Prev, node.Next
+		// The old Next is now Prev.
+		node = node.Prev
+		if node == n {
+			return
+		}
+	}
+}
@@ -0,0 +1,277 @@
+package lnode
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestCycleLength(t *testing.T) {
+	var empty *Node[int]
+	if got := empty.CycleLength(); got != 0 {
+		t.Errorf("CycleLength of nil node = %d, want 0", got)
+	}
+	nodes := chainOf(0, 1, 2, 3, 4)
+	if got := nodes[0].CycleLength(); got != 0 {
+		t.Errorf("CycleLength of linear chain = %d, want 0", got)
+	}
+
+	// Full circle
+	closeLoop(nodes)
+	for _, start := range nodes {
+		if got := start.CycleLength(); got != 5 {
+			t.Errorf("CycleLength of circular chain from %d = %d, want 5", start.Value, got)
+		}
+	}
+
+	// Rho shapes: the tail links back to node loopTo
+	for loopTo := range 5 {
+		nodes := chainOf(0, 1, 2, 3, 4)
+		nodes[4].Next = nodes[loopTo]
+		if got, want := nodes[0].CycleLength(), 5-loopTo; got != want {
+			t.Errorf("CycleLength with tail looping to %d = %d, want %d", loopTo, got, want)
+		}
+	}
+
+	// Single-node loop
+	single := New[int](0)
+	single.Next = single
+	if got := single.CycleLength(); got != 1 {
+		t.Errorf("CycleLength of self-loop = %d, want 1", got)
+	}
+}
+
+func TestCycleNodes(t *testing.T) {
+	var empty *Node[int]
+	if got := empty.CycleNodes(); got == nil || len(got) != 0 {
+		t.Errorf("CycleNodes of nil node = %v, want empty slice", got)
+	}
+	nodes := chainOf(0, 1, 2, 3, 4)
+	if got := nodes[0].CycleNodes(); got == nil || len(got) != 0 {
+		t.Errorf("CycleNodes of linear chain = %v, want empty slice", got)
+	}
+
+	// Rho shapes: the tail links back to node loopTo
+	for loopTo := range 5 {
+		nodes := chainOf(0, 1, 2, 3, 4)
+		nodes[4].Next = nodes[loopTo]
+		if got, want := nodes[0].CycleNodes(), nodes[loopTo:]; !slices.Equal(got, want) {
+			t.Errorf("CycleNodes with tail looping to %d: got %d nodes, want %d", loopTo, len(got), len(want))
+		}
+	}
+
+	// Full circle, started halfway: the loop is entered at the start
+	closeLoop(nodes)
+	if got, want := nodes[2].CycleNodes(), append(slices.Clone(nodes[2:]), nodes[:2]...); !slices.Equal(got, want) {
+		t.Errorf("CycleNodes of circular chain: got %d nodes, want %d", len(got), len(want))
+	}
+}
+
+func TestRingLen(t *testing.T) {
+	var empty *Node[int]
+	if got := empty.RingLen(); got != 0 {
+		t.Errorf("RingLen of nil node = %d, want 0", got)
+	}
+
+	single := New[int](0)
+	if got := single.RingLen(); got != 0 {
+		t.Errorf("RingLen of unlinked node = %d, want 0", got)
+	}
+	single.Next, single.Prev = single, single
+	if got := single.RingLen(); got != 1 {
+		t.Errorf("RingLen of single-node ring = %d, want 1", got)
+	}
+	single.Append(New[int](1))
+	if got := single.RingLen(); got != 2 {
+		t.Errorf("RingLen of two-node ring = %d, want 2", got)
+	}
+
+	nodes := chainOf(0, 1, 2, 3, 4)
+	if got := nodes[0].RingLen(); got != 0 {
+		t.Errorf("RingLen of linear chain = %d, want 0", got)
+	}
+	closeLoop(nodes)
+	for _, node := range nodes {
+		if got := node.RingLen(); got != 5 {
+			t.Errorf("RingLen of ring from %d = %d, want 5", node.Value, got)
+		}
+	}
+
+	// The tail loops back to node 2: nodes 0 and 1 lead into the ring.
+	nodes = chainOf(0, 1, 2, 3, 4)
+	nodes[4].Next = nodes[2]
+	if got := nodes[0].RingLen(); got != 0 {
+		t.Errorf("RingLen of node leading into a loop = %d, want 0", got)
+	}
+	if got := nodes[3].RingLen(); got != 3 {
+		t.Errorf("RingLen of node in a loop = %d, want 3", got)
+	}
+}
+
+func TestAdvance(t *testing.T) {
+	nodes := chainOf(0, 1, 2, 3)
+	if got := nodes[0].Advance(1); got != nil {
+		t.Errorf("Advance on linear chain = %v, want nil", got)
+	}
+
+	closeLoop(nodes)
+	for _, test := range []struct {
+		start, k int
+		want     int
+	}{
+		{start: 0, k: 0, want: 0},
+		{start: 0, k: 1, want: 1},
+		{start: 0, k: 6, want: 2},
+		{start: 1, k: -1, want: 0},
+		{start: 1, k: -2, want: 3},
+		{start: 2, k: -9, want: 1},
+		{start: 3, k: 4, want: 3},
+	} {
+		if got := nodes[test.start].Advance(test.k); got != nodes[test.want] {
+			t.Errorf("Advance(%d) from %d = %v, want %v", test.k, test.start, got, nodes[test.want])
+		}
+	}
+	// Rho shape: 1 --- 2 --- 3 --- 4 loop, and 0 runs into it. The Prev of 1 is 0, off the loop.
+	nodes = chainOf(0, 1, 2, 3, 4)
+	nodes[4].Next = nodes[1]
+	for _, test := range []struct {
+		k, want int
+	}{
+		{k: -1, want: 4},
+		{k: -3, want: 2},
+		{k: -4, want: 1},
+		{k: 5, want: 2},
+	} {
+		if got := nodes[1].Advance(test.k); got != nodes[test.want] {
+			t.Errorf("Advance(%d) on rho loop = %v, want %v", test.k, got, nodes[test.want])
+		}
+	}
+	if got := nodes[0].Advance(-1); got != nil {
+		t.Errorf("Advance(-1) from node running into a loop = %v, want nil", got)
+	}
+}
+
+func TestBreakCycle(t *testing.T) {
+	nodes := chainOf(0, 1, 2, 3)
+	if nodes[0].BreakCycle() {
+		t.Errorf("BreakCycle of linear chain = true, want false")
+	}
+	var empty *Node[int]
+	if empty.BreakCycle() {
+		t.Errorf("BreakCycle of nil node = true, want false")
+	}
+
+	// Full circle, broken from several starting points
+	for start := range 4 {
+		nodes := chainOf(0, 1, 2, 3)
+		closeLoop(nodes)
+		if !nodes[start].BreakCycle() {
+			t.Errorf("BreakCycle of circular chain from %d = false, want true", start)
+		}
+		head := nodes[start]
+		if head.Prev != nil || head.CycleLength() != 0 || !wellLinked(head) || len(valuesOf(head)) != 4 {
+			t.Errorf("BreakCycle of circular chain from %d: got %v, want a linear chain of 4 starting at %d", start, valuesOf(head), start)
+		}
+	}
+
+	// Rho shapes: the tail links back to node loopTo
+	for loopTo := range 4 {
+		nodes := chainOf(0, 1, 2, 3)
+		nodes[3].Next = nodes[loopTo]
+		nodes[loopTo].Prev = nodes[3]
+		if !nodes[0].BreakCycle() {
+			t.Errorf("BreakCycle with tail looping to %d = false, want true", loopTo)
+		}
+		if got, want := valuesOf(nodes[0]), []int{0, 1, 2, 3}; !slices.Equal(got, want) || !wellLinked(nodes[0]) {
+			t.Errorf("BreakCycle with tail looping to %d: got %v, want %v", loopTo, got, want)
+		}
+		if nodes[0].Prev != nil || nodes[3].Next != nil {
+			t.Errorf("BreakCycle with tail looping to %d: ends are not nil", loopTo)
+		}
+	}
+}
+
+func TestStats(t *testing.T) {
+	type stats struct {
+		length     int
+		circular   bool
+		wellFormed bool
+	}
+	get := func(n *Node[int]) stats {
+		length, circular, wellFormed := n.Stats()
+		return stats{length, circular, wellFormed}
+	}
+
+	if got, want := get(nil), (stats{0, false, true}); got != want {
+		t.Errorf("Stats of nil node = %+v, want %+v", got, want)
+	}
+	if got, want := get(New[int](0)), (stats{1, false, true}); got != want {
+		t.Errorf("Stats of single node = %+v, want %+v", got, want)
+	}
+
+	nodes := chainOf(0, 1, 2, 3)
+	if got, want := get(nodes[0]), (stats{4, false, true}); got != want {
+		t.Errorf("Stats of linear chain = %+v, want %+v", got, want)
+	}
+	if got, want := get(nodes[2]), (stats{2, false, true}); got != want {
+		t.Errorf("Stats from the middle = %+v, want %+v", got, want)
+	}
+
+	nodes[2].Prev = nodes[0]
+	if got, want := get(nodes[0]), (stats{4, false, false}); got != want {
+		t.Errorf("Stats with a broken Prev = %+v, want %+v", got, want)
+	}
+
+	nodes = chainOf(0, 1, 2, 3)
+	closeLoop(nodes)
+	if got, want := get(nodes[1]), (stats{4, true, true}); got != want {
+		t.Errorf("Stats of circular chain = %+v, want %+v", got, want)
+	}
+
+	nodes = chainOf(0, 1, 2, 3)
+	nodes[3].Next = nodes[1]
+	if got, want := get(nodes[0]), (stats{4, false, false}); got != want {
+		t.Errorf("Stats of rho-shaped chain = %+v, want %+v", got, want)
+	}
+}
+
+func TestReverseCircular(t *testing.T) {
+	nodes := chainOf(0, 1, 2, 3)
+	nodes[0].ReverseCircular()
+	if got, want := valuesOf(nodes[0]), []int{0, 1, 2, 3}; !slices.Equal(got, want) {
+		t.Errorf("ReverseCircular of linear chain changed it to %v, want %v", got, want)
+	}
+
+	closeLoop(nodes)
+	nodes[1].ReverseCircular()
+	if got, want := valuesOf(nodes[0]), []int{0, 3, 2, 1}; !slices.Equal(got, want) {
+		t.Errorf("ReverseCircular: got %v, want %v", got, want)
+	}
+	if !nodes[0].Circular() || !wellLinked(nodes[0]) || nodes[0].RingLen() != 4 {
+		t.Errorf("ReverseCircular: result is not a well-formed ring")
+	}
+
+	single := New[int](0)
+	single.Next, single.Prev = single, single
+	single.ReverseCircular()
+	if !single.Circular() || single.Prev != single {
+		t.Errorf("ReverseCircular of single-node ring: ring is broken")
+	}
+
+	// Rho shape: 1 --- 2 --- 3 --- 4 loop, and 0 runs into it. The Prev of 1 is 0, off the loop.
+	nodes = chainOf(0, 1, 2, 3, 4)
+	nodes[4].Next = nodes[1]
+	type links struct{ next, prev *Node[int] }
+	var before []links
+	for _, node := range nodes {
+		before = append(before, links{node.Next, node.Prev})
+	}
+	nodes[1].ReverseCircular()
+	for i, node := range nodes {
+		if (links{node.Next, node.Prev}) != before[i] {
+			t.Errorf("ReverseCircular on rho loop changed the links of node %d", i)
+		}
+	}
+	if got := nodes[1].RingLen(); got != 4 {
+		t.Errorf("ReverseCircular on rho loop: RingLen() = %d, want 4", got)
+	}
+}
@@ -0,0 +1,95 @@
+package lnode
+
+import "fmt"
+
+/*
+Immutable is a persistent list: it's never modified, instead each change returns a new Immutable. Unchanged parts are shared between versions, so that keeping many versions around is cheap. The zero value is an empty list, ready to use. Example:
+
+	var empty lnode.Immutable[int]
+	one := empty.With(1)
+	two := one.With(2)     // one still holds [1]
+	three := two.With(3)   // two still holds [1 2]
+	fmt.Println(three.Slice(), two.Slice(), one.Slice())
+	// Output: [1 2 3] [1 2] [1]
+
+	v, err := three.Get(1) // v is 2
+	other, err := three.Without(0)
+	fmt.Println(other.Slice(), three.Slice())
+	// Output: [2 3] [1 2 3]
+
+Internally the values are held in nodes linked from the newest to the oldest value via Next; Prev isn't used since a node can be shared by several versions. What is shared versus copied:
+
+  - With() allocates one node and shares all existing nodes with the original.
+  - Without(i) copies the nodes holding the values after index i, and shares the nodes holding the values before it.
+  - Get(), Len() and Slice() don't allocate nodes.
+
+Values are copied by assignment, so when V is a pointer, map or slice, the referenced data is shared by all versions. Since nodes are never modified once created, versions may be read concurrently.
+*/
+type Immutable[V any] struct {
+	newest *Node[V] // Node holding the last appended value, linked via Next to older ones
+	length int      // Number of values
+}
+
+// Len returns the number of values in the list.
+func (im Immutable[V]) Len() int {
+	return im.length
+}
+
+// With returns a new list that holds the values of the original, plus value appended at the end. This is O(1), all existing nodes are shared.
+func (im Immutable[V]) With(value V) Immutable[V] {
+	return Immutable[V]{
+		newest: &Node[V]{Value: value, Next: im.newest},
+		length: im.length + 1,
+	}
+}
+
+// Without returns a new list that holds the values of the original, minus the one at index. The nodes holding values after index are copied, the ones before index are shared. An error is returned when index is out of range.
+func (im Immutable[V]) Without(index int) (Immutable[V], error) {
+	if index < 0 || index >= im.length {
+		return im, fmt.Errorf("index %d out of range for list of length %d", index, im.length)
+	}
+
+	// Copy the nodes that are newer than index, then skip the one at index.
+	var newest, last *Node[V]
+	node := im.newest
+	for i := im.length - 1; i > index; i-- {
+		copied := &Node[V]{Value: node.Value}
+		if newest == nil {
+			newest = copied
+		} else {
+			last.Next = copied
+		}
+		last = copied
+		node = node.Next
+	}
+	if newest == nil {
+		newest = node.Next
+	} else {
+		last.Next = node.Next
+	}
+	return Immutable[V]{newest: newest, length: im.length - 1}, nil
+}
+
+// Get returns the value at index, where index 0 is the first appended value. An error is returned when index is out of range.
+func (im Immutable[V]) Get(index int) (V, error) {
+	if index < 0 || index >= im.length {
+		var zero V
+		return zero, fmt.Errorf("index %d out of range for list of length %d", index, im.length)
+	}
+	node := im.newest
+	for i := im.length - 1; i > index; i-- {
+		node = node.Next
+	}
+	return node.Value, nil
+}
+
+// Slice returns the values in the list, from the first appended one to the last one.
+func (im Immutable[V]) Slice() []V {
+	out := make([]V, im.length)
+	i := im.length - 1
+	for node := im.newest; node != nil; node = node.Next {
+		out[i] = node.Value
+		i--
+	}
+	return out
+}
@@ -0,0 +1,72 @@
+package lnode
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestImmutable(t *testing.T) {
+	var empty Immutable[int]
+	if empty.Len() != 0 || len(empty.Slice()) != 0 {
+		t.Errorf("Zero Immutable: Len() = %d, Slice() = %v; want empty", empty.Len(), empty.Slice())
+	}
+
+	one := empty.With(1)
+	two := one.With(2)
+	three := two.With(3)
+	branch := two.With(4)
+	for _, test := range []struct {
+		desc string
+		im   Immutable[int]
+		want []int
+	}{
+		{desc: "empty", im: empty, want: []int{}},
+		{desc: "one", im: one, want: []int{1}},
+		{desc: "two", im: two, want: []int{1, 2}},
+		{desc: "three", im: three, want: []int{1, 2, 3}},
+		{desc: "branch", im: branch, want: []int{1, 2, 4}},
+	} {
+		if got := test.im.Slice(); !slices.Equal(got, test.want) {
+			t.Errorf("Immutable %s: Slice() = %v, want %v", test.desc, got, test.want)
+		}
+		if got := test.im.Len(); got != len(test.want) {
+			t.Errorf("Immutable %s: Len() = %d, want %d", test.desc, got, len(test.want))
+		}
+		for i, want := range test.want {
+			if got, err := test.im.Get(i); err != nil || got != want {
+				t.Errorf("Immutable %s: Get(%d) = %d, %v; want %d, nil", test.desc, i, got, err, want)
+			}
+		}
+	}
+
+	// Structure is shared between versions.
+	if three.newest.Next != two.newest || branch.newest.Next != two.newest {
+		t.Errorf("Immutable: With() did not share the existing nodes")
+	}
+
+	for i, want := range [][]int{{2, 3}, {1, 3}, {1, 2}} {
+		got, err := three.Without(i)
+		if err != nil {
+			t.Errorf("Without(%d): unexpected error %v", i, err)
+			continue
+		}
+		if !slices.Equal(got.Slice(), want) || got.Len() != len(want) {
+			t.Errorf("Without(%d) = %v (len %d), want %v", i, got.Slice(), got.Len(), want)
+		}
+	}
+	if got := three.Slice(); !slices.Equal(got, []int{1, 2, 3}) {
+		t.Errorf("Without() changed the original to %v", got)
+	}
+	if without, _ := three.Without(2); without.newest != two.newest {
+		t.Errorf("Without(last) did not share the older nodes")
+	}
+
+	for _, index := range []int{-1, 3} {
+		if _, err := three.Get(index); err == nil {
+			t.Errorf("Get(%d): got nil error, want error", index)
+		}
+		if _, err := three.Without(index); err == nil {
+			t.Errorf("Without(%d): got nil error, want error", index)
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package lnode
+
+/*
+Index speeds up lookups in a long chain that is read often but rarely changed. It holds pointers to every stride-th node, so that At() and SortedSearch() can jump close to their target and then walk at most stride nodes, rather than walking the chain from the start. An Index is built by BuildIndex(). Example:
+
+	// anchor: 0 --- 1 --- 2 --- ... --- 999
+	ix := anchor.BuildIndex(32)
+	fmt.Println(ix.At(500).Value)
+	// Output: 500
+
+An Index is a snapshot: it isn't updated when the chain changes. After nodes are added, removed or moved, the Index is stale and must be rebuilt; using a stale Index gives wrong results, or may walk into nodes that are no longer part of the chain. Changing values is fine for At(), but SortedSearch() requires that the chain stays sorted. Like the rest of the package, Index is not thread-safe.
+*/
+type Index[V any] struct {
+	samples []*Node[V] // samples[k] is the node at offset k*stride
+	stride  int        // Distance between samples
+	length  int        // Number of nodes in the indexed chain
+}
+
+/*
+BuildIndex walks the chain once, starting at the node and going "to the right", and returns an Index over it, with a sample at every stride-th node; the node itself is at offset 0. A smaller stride makes lookups faster, at the cost of more memory. A stride less than 1 is treated as 1. Circular chains are handled like VisitByNext() does: every node is indexed once.
+*/
+func (n *Node[V]) BuildIndex(stride int) *Index[V] {
+	ix := &Index[V]{stride: max(stride, 1)}
+	n.Each(func(node *Node[V]) {
+		if ix.length%ix.stride == 0 {
+			ix.samples = append(ix.samples, node)
+		}
+		ix.length++
+	})
+	return ix
+}
+
+// Len returns the number of nodes in the indexed chain.
+func (ix *Index[V]) Len() int {
+	return ix.length
+}
+
+// At returns the node at offset i, or nil when i is out of range. It walks less than stride nodes.
+func (ix *Index[V]) At(i int) *Node[V] {
+	if i < 0 || i >= ix.length {
+		return nil
+	}
+	node := ix.samples[i/ix.stride]
+	for range i % ix.stride {
+		node = node.Next
+	}
+	return node
+}
+
+/*
+SortedSearch is the indexed counterpart of the function SortedSearch(): for a chain that is sorted according to less, it returns the first node whose value doesn't sort before target, or nil when all values do. The bool is true when that node holds a value equal to target. The samples are bisected, after which at most stride nodes are walked, so this takes O(log(N/stride) + stride) time.
+*/
+func (ix *Index[V]) SortedSearch(target V, less func(a, b V) bool) (*Node[V], bool) {
+	// Find the first sample that doesn't sort before target; the answer lies after the sample before it.
+	lo, hi := 0, len(ix.samples)
+	for lo < hi {
+		mid := int(uint(lo+hi) >> 1)
+		if less(ix.samples[mid].Value, target) {
+			lo = mid + 1
+		} else {
+			hi = mid
+		}
+	}
+	if lo == 0 {
+		if ix.length == 0 {
+			return nil, false
+		}
+		return ix.samples[0], !less(target, ix.samples[0].Value)
+	}
+
+	offset := (lo - 1) * ix.stride
+	node := ix.samples[lo-1]
+	for ; offset < ix.length; offset++ {
+		if !less(node.Value, target) {
+			return node, !less(target, node.Value)
+		}
+		node = node.Next
+	}
+	return nil, false
+}
@@ -0,0 +1,70 @@
+package lnode
+
+import "testing"
+
+func TestIndexAt(t *testing.T) {
+	head, _ := BuildChain([]int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9})
+	for _, stride := range []int{0, 1, 3, 4, 10, 20} {
+		ix := head.BuildIndex(stride)
+		if got := ix.Len(); got != 10 {
+			t.Errorf("BuildIndex(%d): Len() = %d, want 10", stride, got)
+		}
+		for i := range 10 {
+			if got := ix.At(i); got == nil || got.Value != i {
+				t.Errorf("BuildIndex(%d): At(%d) = %v, want node holding %d", stride, i, got, i)
+			}
+		}
+		if ix.At(-1) != nil || ix.At(10) != nil {
+			t.Errorf("BuildIndex(%d): At() out of range must return nil", stride)
+		}
+	}
+
+	var empty *Node[int]
+	if ix := empty.BuildIndex(4); ix.Len() != 0 || ix.At(0) != nil {
+		t.Errorf("BuildIndex of nil node: got Len() %d, want an empty index", ix.Len())
+	}
+
+	nodes := chainOf(0, 1, 2, 3, 4)
+	closeLoop(nodes)
+	ix := nodes[2].BuildIndex(2)
+	if ix.Len() != 5 || ix.At(0) != nodes[2] || ix.At(4) != nodes[1] {
+		t.Errorf("BuildIndex of circular chain: Len() = %d, want 5 nodes starting at the node itself", ix.Len())
+	}
+}
+
+func TestIndexSortedSearch(t *testing.T) {
+	less := func(a, b int) bool { return a < b }
+	head, _ := BuildChain([]int{1, 3, 3, 5, 7, 9, 11, 13})
+	for _, stride := range []int{1, 2, 3, 8, 100} {
+		ix := head.BuildIndex(stride)
+		for target := 0; target <= 14; target++ {
+			wantNode, wantFound := SortedSearch(head, target, less)
+			gotNode, gotFound := ix.SortedSearch(target, less)
+			if gotNode != wantNode || gotFound != wantFound {
+				t.Errorf("Index(%d).SortedSearch(%d) = %v, %v; want %v, %v", stride, target, gotNode, gotFound, wantNode, wantFound)
+			}
+		}
+	}
+
+	var empty *Node[int]
+	if node, found := empty.BuildIndex(2).SortedSearch(1, less); node != nil || found {
+		t.Errorf("SortedSearch in empty index = %v, %v; want nil, false", node, found)
+	}
+}
+
+func BenchmarkIndexAt(b *testing.B) {
+	ix := benchmarkChain(1000).BuildIndex(32)
+	for b.Loop() {
+		ix.At(999)
+	}
+}
+
+func BenchmarkWalkAt(b *testing.B) {
+	head := benchmarkChain(1000)
+	for b.Loop() {
+		node := head
+		for range 999 {
+			node = node.Next
+		}
+	}
+}
@@ -0,0 +1,97 @@
+package lnode
+
+import (
+	"iter"
+	"slices"
+)
+
+/*
+FilterSeq returns an iterator over the node and all next nodes ("to the right") whose value satisfies pred. No new chain is built: nodes are yielded lazily, so that the iterator composes with other iterators and stops as soon as the caller breaks out of the loop. Circular chains are handled like VisitByNext() does: every node is considered once.
+
+Example:
+
+	// anchor: 1 --- 2 --- 3 --- 4
+	for node := range anchor.FilterSeq(func(v int) bool { return v%2 == 0 }) {
+		fmt.Println(node.Value)
+	}
+	// Output:
+	// 2
+	// 4
+*/
+func (n *Node[V]) FilterSeq(pred func(V) bool) iter.Seq[*Node[V]] {
+	return func(yield func(*Node[V]) bool) {
+		n.VisitByNext(func(node *Node[V]) bool {
+			if !pred(node.Value) {
+				return true
+			}
+			return yield(node)
+		})
+	}
+}
+
+/*
+ChunksSeq returns an iterator over successive chunks of up to size values, starting at the node and going "to the right". Chunks are produced lazily, so a large chain can be processed without materializing all chunks at once, and breaking out of the loop stops the walk. The last chunk may hold fewer than size values. A size of zero or less is treated as 1. Unlike the batches of VisitBatches(), every chunk is a fresh slice that the caller may keep. Circular chains are handled like VisitByNext() does: every node is visited once.
+
+Example:
+
+	// anchor: 0 --- 1 --- 2 --- 3 --- 4
+	for chunk := range anchor.ChunksSeq(2) {
+		fmt.Println(chunk)
+	}
+	// Output:
+	// [0 1]
+	// [2 3]
+	// [4]
+*/
+func (n *Node[V]) ChunksSeq(size int) iter.Seq[[]V] {
+	return func(yield func([]V) bool) {
+		n.VisitBatches(size, func(batch []V) bool {
+			return yield(slices.Clone(batch))
+		})
+	}
+}
+
+/*
+Values returns an iterator over the values of the node and all next nodes ("to the right"). This is the lazy counterpart of ToSlice(): breaking out of the loop stops the walk. Circular chains are handled like VisitByNext() does: every value is yielded once.
+
+Example:
+
+	// anchor: 0 --- 1 --- 2
+	for v := range anchor.Values() {
+		fmt.Println(v)
+	}
+	// Output:
+	// 0
+	// 1
+	// 2
+*/
+func (n *Node[V]) Values() iter.Seq[V] {
+	return valuesSeq(n.VisitByNext)
+}
+
+/*
+BackwardValues is the mirror of Values(): it returns an iterator over the values of the node and all previous nodes ("to the left"). This is the lazy counterpart of ToSliceReverse(), and convenient when holding the tail of a chain. Circular chains are handled like VisitByPrev() does: every value is yielded once.
+
+Example:
+
+	// anchor: 0 --- 1 --- 2
+	for v := range anchor.Tail().BackwardValues() {
+		fmt.Println(v)
+	}
+	// Output:
+	// 2
+	// 1
+	// 0
+*/
+func (n *Node[V]) BackwardValues() iter.Seq[V] {
+	return valuesSeq(n.VisitByPrev)
+}
+
+// valuesSeq turns a walk, such as VisitByNext() or VisitByPrev() of some node, into an iterator over the values. The walk takes care of stopping early and of circular chains.
+func valuesSeq[V any](visit func(func(*Node[V]) bool)) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		visit(func(node *Node[V]) bool {
+			return yield(node.Value)
+		})
+	}
+}
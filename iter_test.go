@@ -0,0 +1,101 @@
+package lnode
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestFilterSeq(t *testing.T) {
+	even := func(v int) bool { return v%2 == 0 }
+	nodes := chainOf(0, 1, 2, 3, 4, 5, 6)
+
+	var got []int
+	for node := range nodes[1].FilterSeq(even) {
+		got = append(got, node.Value)
+	}
+	if want := []int{2, 4, 6}; !slices.Equal(got, want) {
+		t.Errorf("FilterSeq: got %v, want %v", got, want)
+	}
+
+	got = nil
+	for node := range nodes[0].FilterSeq(even) {
+		if node.Value > 2 {
+			break
+		}
+		got = append(got, node.Value)
+	}
+	if want := []int{0, 2}; !slices.Equal(got, want) {
+		t.Errorf("FilterSeq with break: got %v, want %v", got, want)
+	}
+
+	closeLoop(nodes)
+	got = nil
+	for node := range nodes[3].FilterSeq(even) {
+		got = append(got, node.Value)
+	}
+	if want := []int{4, 6, 0, 2}; !slices.Equal(got, want) {
+		t.Errorf("FilterSeq on circular chain: got %v, want %v", got, want)
+	}
+
+	var empty *Node[int]
+	for node := range empty.FilterSeq(even) {
+		t.Errorf("FilterSeq on nil node yielded %v", node)
+	}
+}
+
+func TestChunksSeq(t *testing.T) {
+	nodes := chainOf(0, 1, 2, 3, 4)
+	got := slices.Collect(nodes[0].ChunksSeq(2))
+	if want := [][]int{{0, 1}, {2, 3}, {4}}; !slices.EqualFunc(got, want, slices.Equal) {
+		t.Errorf("ChunksSeq(2) = %v, want %v", got, want)
+	}
+
+	got = nil
+	for chunk := range nodes[0].ChunksSeq(3) {
+		got = append(got, chunk)
+		break
+	}
+	if want := [][]int{{0, 1, 2}}; !slices.EqualFunc(got, want, slices.Equal) {
+		t.Errorf("ChunksSeq(3) with break = %v, want %v", got, want)
+	}
+
+	closeLoop(nodes)
+	got = slices.Collect(nodes[3].ChunksSeq(0))
+	if want := [][]int{{3}, {4}, {0}, {1}, {2}}; !slices.EqualFunc(got, want, slices.Equal) {
+		t.Errorf("ChunksSeq(0) of circular chain = %v, want %v", got, want)
+	}
+}
+
+func TestValues(t *testing.T) {
+	nodes := chainOf(0, 1, 2, 3)
+	if got := slices.Collect(nodes[1].Values()); !slices.Equal(got, []int{1, 2, 3}) {
+		t.Errorf("Values = %v, want [1 2 3]", got)
+	}
+	if got := slices.Collect(nodes[2].BackwardValues()); !slices.Equal(got, []int{2, 1, 0}) {
+		t.Errorf("BackwardValues = %v, want [2 1 0]", got)
+	}
+
+	var got []int
+	for v := range nodes[3].BackwardValues() {
+		if v == 1 {
+			break
+		}
+		got = append(got, v)
+	}
+	if !slices.Equal(got, []int{3, 2}) {
+		t.Errorf("BackwardValues with break = %v, want [3 2]", got)
+	}
+
+	closeLoop(nodes)
+	if got := slices.Collect(nodes[1].Values()); !slices.Equal(got, []int{1, 2, 3, 0}) {
+		t.Errorf("Values of circular chain = %v, want [1 2 3 0]", got)
+	}
+	if got := slices.Collect(nodes[1].BackwardValues()); !slices.Equal(got, []int{1, 0, 3, 2}) {
+		t.Errorf("BackwardValues of circular chain = %v, want [1 0 3 2]", got)
+	}
+
+	var empty *Node[int]
+	if got := slices.Collect(empty.Values()); len(got) != 0 {
+		t.Errorf("Values of nil node = %v, want nothing", got)
+	}
+}
@@ -1,6 +1,19 @@
 // Package lnode provides generic nodes for doubly linked lists.
 package lnode
 
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrLinked is returned when a node that's already part of a chain is offered for insertion.
+	ErrLinked = errors.New("node is already linked into a chain")
+
+	// ErrNotFound is returned when no node in a chain satisfies a search.
+	ErrNotFound = errors.New("no matching node in chain")
+)
+
 // Node is the receiver.
 type Node[V any] struct {
 	Value V        // Generic contained value
@@ -20,7 +33,7 @@ func New[V any](value V) *Node[V] {
 }
 
 /*
-Prepend adds a new node "left" of the current node. Example:
+Prepend adds a new node "left" of the current node. Offering the node itself, or one of its neighbors, would make the chain loop back on itself; in that case nothing happens. Example:
 
 	anchor := lnode.New[int](0)
 	anchor.Prepend(New[int](-1))
@@ -29,6 +42,9 @@ Prepend adds a new node "left" of the current node. Example:
 	//        ^anchor
 */
 func (n *Node[V]) Prepend(node *Node[V]) {
+	if isSelfOrNeighbor(n, node) {
+		return
+	}
 	oldPrev := n.Prev
 	node.Next = n
 	n.Prev = node
@@ -39,7 +55,7 @@ func (n *Node[V]) Prepend(node *Node[V]) {
 }
 
 /*
-Prepend adds a new node "right" of the current node. Example:
+Append adds a new node "right" of the current node. Offering the node itself, or one of its neighbors, would make the chain loop back on itself; in that case nothing happens. Example:
 
 	anchor := lnode.New[int](0)
 	anchor.Append(New[int](1))
@@ -48,6 +64,9 @@ Prepend adds a new node "right" of the current node. Example:
 	// ^anchor
 */
 func (n *Node[V]) Append(node *Node[V]) {
+	if isSelfOrNeighbor(n, node) {
+		return
+	}
 	oldNext := n.Next
 	node.Prev = n
 	n.Next = node
@@ -57,6 +76,69 @@ func (n *Node[V]) Append(node *Node[V]) {
 	}
 }
 
+// isSelfOrNeighbor returns true when node is n itself, or is linked next to n. A nil node is neither.
+func isSelfOrNeighbor[V any](n, node *Node[V]) bool {
+	return node != nil && (node == n || node == n.Next || node == n.Prev)
+}
+
+/*
+PrependSafe is like Prepend, but refuses to add a node that's already part of a chain, i.e. that has a non-nil Next or Prev. Such a node would otherwise be cross-linked into two chains, corrupting both. In that case ErrLinked is returned and nothing is changed. Offering the node itself, or nil, is an error too. Example:
+
+	anchor := lnode.New[int](0)
+	if err := anchor.PrependSafe(lnode.New[int](-1)); err != nil {
+		log.Fatal(err)
+	}
+	// Structure:
+	// -1 --- 0
+	//        ^anchor
+
+	err := anchor.PrependSafe(anchor.Prev)
+	// err is ErrLinked, anchor.Prev is already linked
+*/
+func (n *Node[V]) PrependSafe(node *Node[V]) error {
+	if err := checkUnlinked(n, node); err != nil {
+		return err
+	}
+	n.Prepend(node)
+	return nil
+}
+
+/*
+AppendSafe is like Append, but refuses to add a node that's already part of a chain, i.e. that has a non-nil Next or Prev. Such a node would otherwise be cross-linked into two chains, corrupting both. In that case ErrLinked is returned and nothing is changed. Offering the node itself, or nil, is an error too. Example:
+
+	anchor := lnode.New[int](0)
+	if err := anchor.AppendSafe(lnode.New[int](1)); err != nil {
+		log.Fatal(err)
+	}
+	// Structure:
+	// 0 --- 1
+	// ^anchor
+
+	err := anchor.AppendSafe(anchor.Next)
+	// err is ErrLinked, anchor.Next is already linked
+*/
+func (n *Node[V]) AppendSafe(node *Node[V]) error {
+	if err := checkUnlinked(n, node); err != nil {
+		return err
+	}
+	n.Append(node)
+	return nil
+}
+
+// checkUnlinked returns an error when node can't be safely inserted next to n.
+func checkUnlinked[V any](n, node *Node[V]) error {
+	if node == nil {
+		return errors.New("cannot insert a nil node")
+	}
+	if node == n {
+		return errors.New("cannot insert a node next to itself")
+	}
+	if node.Next != nil || node.Prev != nil {
+		return ErrLinked
+	}
+	return nil
+}
+
 /*
 VisitByNext invokes a visitor function (callback) on the applicable node, and on all next nodes ("to the right"). The callback returns a bool indicating whether the processing should stop. When the callback returns false, no further nodes are processed.
 
@@ -160,6 +242,43 @@ func (n *Node[V]) VisitByPrev(fn func(node *Node[V]) bool) {
 	}
 }
 
+/*
+Each invokes a function on the node and on all next nodes ("to the right"). It's VisitByNext() for callers that never stop early, so that the callback doesn't need to return a bool. Circular chains are handled like VisitByNext() does. Example:
+
+	anchor.Each(func(node *lnode.Node[int]) {
+		fmt.Println(node.Value)
+	})
+*/
+func (n *Node[V]) Each(fn func(*Node[V])) {
+	n.VisitByNext(func(node *Node[V]) bool {
+		fn(node)
+		return true
+	})
+}
+
+/*
+EachValue is like Each(), but passes only the contained values to the function. Example:
+
+	anchor.EachValue(func(v int) {
+		fmt.Println(v)
+	})
+*/
+func (n *Node[V]) EachValue(fn func(V)) {
+	n.Each(func(node *Node[V]) {
+		fn(node.Value)
+	})
+}
+
+/*
+Tap invokes fn on the value of the node and of all next nodes ("to the right"), for its side effects such as logging or metrics, and returns the node itself. Unlike EachValue(), it can be placed between other calls in a pipeline. Circular chains are handled like VisitByNext() does. Example:
+
+	sum := lnode.Sum(anchor.Tap(func(v int) { log.Println("value:", v) }))
+*/
+func (n *Node[V]) Tap(fn func(V)) *Node[V] {
+	n.EachValue(fn)
+	return n
+}
+
 /*
 Head returns the "leftmost" node in a chain, i.e., the node where Prev is nil. The runtime is O(N) with N being the number of nodes "to the left".
 
@@ -224,6 +343,49 @@ func (n *Node[V]) Tail() *Node[V] {
 	return n
 }
 
+/*
+Ends returns both the head and the tail of the chain that the node is part of, i.e., what Head() and Tail() return, but from a single call: it walks "to the left" for the head and "to the right" for the tail, so every node is visited once. For a circular chain (see function Circular()), it returns nil, nil.
+
+Example:
+
+	// anchor: 0 --- 1 --- 2 --- 3
+	head, tail := anchor.Next.Ends()
+	fmt.Println(head.Value, tail.Value)
+	// Output: 0 3
+*/
+func (n *Node[V]) Ends() (head, tail *Node[V]) {
+	head = n.Head()
+	if head == nil {
+		return nil, nil
+	}
+	return head, n.Tail()
+}
+
+// IsEmpty returns true when n is nil, i.e., when there is no chain at all.
+func IsEmpty[V any](n *Node[V]) bool {
+	return n == nil
+}
+
+// IsSingleton returns true when n is a single node that isn't linked to others: both its Next and Prev are nil. A one-node circular chain, whose Next and Prev point to the node itself, is not a singleton by this definition (see RingLen() to detect it).
+func IsSingleton[V any](n *Node[V]) bool {
+	return n != nil && n.Next == nil && n.Prev == nil
+}
+
+/*
+Detached returns true when the node isn't linked to other nodes: both its Next and Prev are nil, as is the case for a node that was removed by e.g. DeleteN() or Reset(). This is a cheap check before operating on a node that may have been removed from its chain. Detached is the method form of IsSingleton(), and shares its ambiguity: a node that was never part of a larger chain, i.e., a legitimate single-node chain, is detached as well. Callers that need to tell these apart must track that themselves, e.g. by checking the length of the chain that they expect the node to be in. A nil node is not detached. Example:
+
+	// anchor: 0 --- 1 --- 2
+	node := anchor.Next
+	fmt.Println(node.Detached())
+	// Output: false
+	node.DeleteN(1)
+	fmt.Println(node.Detached())
+	// Output: true
+*/
+func (n *Node[V]) Detached() bool {
+	return IsSingleton(n)
+}
+
 /*
 Delete removes a node from the list. Example:
 
@@ -292,3 +454,1203 @@ func (n *Node[V]) Circular() bool {
 	}
 	return false
 }
+
+/*
+DeleteN removes the node and the next count-1 nodes from the list in one operation. When fewer nodes are available, count is clamped to what's there. The removed nodes have their Next and Prev pointers cleared.
+
+DeleteN returns a node that's still in the list: the first node after the removed run, or when there is none, the node before it. When the whole list is removed, nil is returned. When count is zero or less, nothing is removed and the node itself is returned.
+
+Example:
+
+	anchor := lnode.New[int](0)
+	anchor.Append(New[int](1))
+	anchor.Next.Append(New[int](2))
+	anchor.Next.Next.Append(New[int](3))
+	// Structure:
+	// 0 --- 1 --- 2 --- 3
+	// ^anchor
+
+	n := anchor.Next.DeleteN(2)
+	// New structure:
+	// 0 --- 3
+	// ^anchor
+	//       ^n
+*/
+func (n *Node[V]) DeleteN(count int) *Node[V] {
+	if n == nil || count <= 0 {
+		return n
+	}
+
+	// Find the last node to delete, not wrapping around in circular chains.
+	last := n
+	for i := 1; i < count && last.Next != nil && last.Next != n; i++ {
+		last = last.Next
+	}
+	prev := n.Prev
+	next := last.Next
+
+	// The entire circular chain is deleted.
+	if next == n {
+		prev, next = nil, nil
+	}
+
+	for node := n; ; {
+		following := node.Next
+		node.Next = nil
+		node.Prev = nil
+		if node == last {
+			break
+		}
+		node = following
+	}
+
+	if prev != nil {
+		prev.Next = next
+	}
+	if next != nil {
+		next.Prev = prev
+		return next
+	}
+	return prev
+}
+
+// detach removes the node from its list and clears its Next and Prev pointers.
+func (n *Node[V]) detach() {
+	n.Delete()
+	n.Next = nil
+	n.Prev = nil
+}
+
+/*
+MoveBefore removes the node from its current position and reinserts it "left" of target. The neighbors at the old position are joined together. Nothing happens when target is nil, when target is the node itself, or when the node already is immediately before target. Example:
+
+	anchor := lnode.New[int](0)
+	anchor.Append(New[int](1))
+	anchor.Next.Append(New[int](2))
+	// Structure:
+	// 0 --- 1 --- 2
+	// ^anchor
+
+	anchor.Next.Next.MoveBefore(anchor)
+	// New structure:
+	// 2 --- 0 --- 1
+	//       ^anchor
+*/
+func (n *Node[V]) MoveBefore(target *Node[V]) {
+	if target == nil || target == n || n.Next == target {
+		return
+	}
+	n.detach()
+	target.Prepend(n)
+}
+
+/*
+MoveAfter removes the node from its current position and reinserts it "right" of target. The neighbors at the old position are joined together. Nothing happens when target is nil, when target is the node itself, or when the node already is immediately after target. Example:
+
+	anchor := lnode.New[int](0)
+	anchor.Append(New[int](1))
+	anchor.Next.Append(New[int](2))
+	// Structure:
+	// 0 --- 1 --- 2
+	// ^anchor
+
+	anchor.MoveAfter(anchor.Next.Next)
+	// New structure:
+	// 1 --- 2 --- 0
+	//             ^anchor
+*/
+func (n *Node[V]) MoveAfter(target *Node[V]) {
+	if target == nil || target == n || n.Prev == target {
+		return
+	}
+	n.detach()
+	target.Append(n)
+}
+
+/*
+Middle returns the middle node of the chain that starts at the node and extends "to the right". For an even number of nodes there are two middles, and the second one is returned. The middle is found in a single pass, using a slow pointer that advances one node and a fast pointer that advances two.
+
+In the case of a circular chain (see function Circular()), Middle() returns nil. This also holds when the chain only loops back somewhere further to the right.
+
+Example:
+
+	anchor := lnode.New[int](0)
+	anchor.Append(New[int](1))
+	anchor.Next.Append(New[int](2))
+	anchor.Next.Next.Append(New[int](3))
+	// Structure:
+	// 0 --- 1 --- 2 --- 3
+	// ^anchor     ^middle
+
+	fmt.Println(anchor.Middle().Value)
+	// Output: 2
+*/
+func (n *Node[V]) Middle() *Node[V] {
+	slow, fast := n, n
+	for fast != nil && fast.Next != nil {
+		slow = slow.Next
+		fast = fast.Next.Next
+		if slow == fast {
+			return nil
+		}
+	}
+	return slow
+}
+
+/*
+Find returns the first node, starting at the node itself and going "to the right", whose value satisfies pred. When no node matches, nil is returned. Circular chains are handled like VisitByNext() does: every node is checked once. Example:
+
+	anchor := lnode.New[int](1)
+	anchor.Append(New[int](2))
+	anchor.Next.Append(New[int](3))
+
+	found := anchor.Find(func(v int) bool { return v > 1 })
+	fmt.Println(found.Value)
+	// Output: 2
+*/
+func (n *Node[V]) Find(pred func(V) bool) *Node[V] {
+	var found *Node[V]
+	n.VisitByNext(func(node *Node[V]) bool {
+		if pred(node.Value) {
+			found = node
+		}
+		return found == nil
+	})
+	return found
+}
+
+/*
+SeekNext returns the first node strictly "to the right" of the node, whose value satisfies pred. Unlike Find(), the node itself is not considered, which makes it convenient to resume a search from the previous match. When no node matches, nil is returned. In a circular chain, the search stops before reaching the node again. Example:
+
+	// anchor: 1 --- 2 --- 3 --- 4
+	even := func(v int) bool { return v%2 == 0 }
+	for node := anchor.Find(even); node != nil; node = node.SeekNext(even) {
+		fmt.Println(node.Value)
+	}
+	// Output:
+	// 2
+	// 4
+*/
+func (n *Node[V]) SeekNext(pred func(V) bool) *Node[V] {
+	return seek(n, pred, n.VisitByNext)
+}
+
+/*
+SeekPrev is the mirror of SeekNext(): it returns the first node strictly "to the left" of the node, whose value satisfies pred, or nil when there is none. Example:
+
+	// anchor: 1 --- 2 --- 3 --- 4
+	node := anchor.Tail().SeekPrev(func(v int) bool { return v%2 == 0 })
+	fmt.Println(node.Value)
+	// Output: 2
+*/
+func (n *Node[V]) SeekPrev(pred func(V) bool) *Node[V] {
+	return seek(n, pred, n.VisitByPrev)
+}
+
+// seek returns the first node other than n that the visitor function reaches, and that satisfies pred.
+func seek[V any](n *Node[V], pred func(V) bool, visit func(fn func(node *Node[V]) bool)) *Node[V] {
+	var found *Node[V]
+	visit(func(node *Node[V]) bool {
+		if node != n && pred(node.Value) {
+			found = node
+		}
+		return found == nil
+	})
+	return found
+}
+
+/*
+RotateUntil searches the chain that the node is part of, from its head, for the first node whose value satisfies pred. The chain is rotated so that this node becomes the new head: the nodes before it are moved, in order, behind the tail. The new head is returned. When no node matches, an error wrapping ErrNotFound is returned and the chain is left untouched.
+
+A circular chain is not relinked: any node can serve as its start. The first matching node, searching rightward from the node itself, is returned.
+
+Example:
+
+	anchor := lnode.New[int](0)
+	for i := 1; i < 4; i++ {
+		anchor.Tail().Append(lnode.New[int](i))
+	}
+	// Structure:
+	// 0 --- 1 --- 2 --- 3
+	// ^anchor
+
+	head, err := anchor.RotateUntil(func(v int) bool { return v > 1 })
+	// New structure:
+	// 2 --- 3 --- 0 --- 1
+	// ^head       ^anchor
+*/
+func (n *Node[V]) RotateUntil(pred func(V) bool) (*Node[V], error) {
+	found := n.headOrSelf().Find(pred)
+	if found == nil {
+		return nil, ErrNotFound
+	}
+	found.rotateToHead()
+	return found, nil
+}
+
+// headOrSelf returns the head of the chain, or the node itself when the chain is circular and has no head.
+func (n *Node[V]) headOrSelf() *Node[V] {
+	if head := n.Head(); head != nil {
+		return head
+	}
+	return n
+}
+
+// rotateToHead relinks a linear chain so that node becomes its head: the nodes before it are moved, in order, behind the tail. Circular chains are left alone, any node can serve as their start.
+func (n *Node[V]) rotateToHead() {
+	head, tail := n.Head(), n.Tail()
+	if head == nil || tail == nil || head == n {
+		return
+	}
+	newTail := n.Prev
+	tail.Next = head
+	head.Prev = tail
+	newTail.Next = nil
+	n.Prev = nil
+}
+
+/*
+VisitBatches collects the values of the node and of all next nodes ("to the right") into batches of up to batchSize values, and invokes a callback once per batch. This suits bulk processing, such as handing values to functions that take slices. Note that it isn't necessarily faster than VisitByNext(): walking the chain dominates the cost, and filling the batch adds a copy per value. The last batch may hold fewer than batchSize values. When the callback returns false, no further batches are processed. A batchSize of zero or less is treated as 1.
+
+The batch slice is reused between calls: the callback must copy the values if it needs them after returning.
+
+Circular chains are handled like VisitByNext() does: every node is visited once.
+
+Example:
+
+	anchor := lnode.New[int](0)
+	for i := 1; i < 5; i++ {
+		anchor.Tail().Append(lnode.New[int](i))
+	}
+
+	anchor.VisitBatches(2, func(batch []int) bool {
+		fmt.Println(batch)
+		return true
+	})
+	// Output:
+	// [0 1]
+	// [2 3]
+	// [4]
+*/
+func (n *Node[V]) VisitBatches(batchSize int, fn func(batch []V) bool) {
+	if n == nil {
+		return
+	}
+	if batchSize <= 0 {
+		batchSize = 1
+	}
+
+	batch := make([]V, 0, batchSize)
+	start := n
+	for n != nil {
+		batch = append(batch, n.Value)
+		if len(batch) == batchSize {
+			if !fn(batch) {
+				return
+			}
+			batch = batch[:0]
+		}
+		n = n.Next
+		if n == start {
+			break
+		}
+	}
+	if len(batch) > 0 {
+		fn(batch)
+	}
+}
+
+/*
+DeleteCopy returns a copy of the chain that the node is part of, leaving out the node itself. The original chain is left intact, which suits code that keeps snapshots and treats each version as immutable. All other nodes are copied, so this takes O(N) time and memory; the values themselves are copied by assignment.
+
+For a linear chain, the head of the copy is returned. For a circular chain (see function Circular()), the copy is circular too, and the copy of the node after the receiver is returned. When the node is the only one in its chain, nil is returned.
+
+Example:
+
+	anchor := lnode.New[int](0)
+	anchor.Append(New[int](1))
+	anchor.Next.Append(New[int](2))
+	// Structure:
+	// 0 --- 1 --- 2
+	// ^anchor
+
+	snapshot := anchor.Next.DeleteCopy()
+	// Structure of snapshot, anchor is unchanged:
+	// 0 --- 2
+	// ^snapshot
+*/
+func (n *Node[V]) DeleteCopy() *Node[V] {
+	if n == nil {
+		return nil
+	}
+
+	start := n.Head()
+	circular := start == nil
+	if circular {
+		start = n.Next
+	}
+
+	var b builder[V]
+	start.VisitByNext(func(node *Node[V]) bool {
+		if node == n {
+			// In a circular chain, the receiver is the last one seen.
+			return !circular
+		}
+		b.add(node.Value)
+		return true
+	})
+
+	if circular && b.head != nil {
+		b.tail.Next = b.head
+		b.head.Prev = b.tail
+	}
+	return b.head
+}
+
+// advance returns the node after n, or nil when the chain ends or when it loops back to start.
+func (n *Node[V]) advance(start *Node[V]) *Node[V] {
+	if n.Next == start {
+		return nil
+	}
+	return n.Next
+}
+
+// builder constructs a new chain by appending values at its tail in O(1).
+type builder[V any] struct {
+	head *Node[V] // First node, nil while no values were added
+	tail *Node[V] // Last node
+}
+
+// add appends a new node holding value to the chain under construction.
+func (b *builder[V]) add(value V) {
+	b.link(New[V](value))
+}
+
+// link appends an existing node to the chain under construction. Its previous links are discarded.
+func (b *builder[V]) link(node *Node[V]) {
+	node.Next = nil
+	node.Prev = nil
+	if b.head == nil {
+		b.head = node
+	} else {
+		b.tail.Append(node)
+	}
+	b.tail = node
+}
+
+/*
+RemoveRange removes the nodes at offsets start (inclusive) up to end (exclusive), where the node itself is at offset 0 and the offsets increase "to the right". This is the linked-list counterpart of reslicing. The removed nodes have their Next and Prev pointers cleared. An error is returned, and nothing is removed, when start is negative, when start exceeds end, or when there are fewer than end nodes.
+
+RemoveRange returns the head of the remaining chain, or nil when no nodes remain. A circular chain has no head; in that case the node itself is returned, or when it was removed, the node that followed the removed range.
+
+Example:
+
+	anchor := lnode.New[int](0)
+	for i := 1; i < 5; i++ {
+		anchor.Tail().Append(lnode.New[int](i))
+	}
+	// Structure:
+	// 0 --- 1 --- 2 --- 3 --- 4
+	// ^anchor
+
+	head, err := anchor.RemoveRange(1, 3)
+	// New structure:
+	// 0 --- 3 --- 4
+	// ^anchor
+	// ^head
+*/
+func (n *Node[V]) RemoveRange(start, end int) (*Node[V], error) {
+	if start < 0 || start > end {
+		return nil, fmt.Errorf("invalid range [%d, %d)", start, end)
+	}
+
+	var first *Node[V]
+	available := 0
+	n.VisitByNext(func(node *Node[V]) bool {
+		if available == start {
+			first = node
+		}
+		available++
+		return available < end
+	})
+	if available < end {
+		return nil, fmt.Errorf("range [%d, %d) exceeds the %d available nodes", start, end, available)
+	}
+
+	anchor := n
+	if start < end {
+		rest := first.DeleteN(end - start)
+		if start == 0 {
+			anchor = rest
+		}
+	}
+	return anchor.headOrSelf(), nil
+}
+
+/*
+Splice is the counterpart of JavaScript's Array.splice(): it removes deleteCount nodes starting at offset start, and inserts new nodes holding the insert values in their place. The node itself is at offset 0, and the offsets increase "to the right". The values of the removed nodes are returned, and the removed nodes have their Next and Prev pointers cleared.
+
+Unlike RemoveRange(), Splice is lenient about its arguments, like its JavaScript namesake: a start beyond the last node appends the insert values at the tail, a deleteCount that exceeds the available nodes removes everything from start onward, and negative arguments are treated as 0. Without insert values, Splice only removes nodes.
+
+Splice returns the head of the resulting chain, or nil when no nodes remain. When a circular chain is spliced, it stays circular; since it has no head, the node itself is returned, or when it was removed, the first inserted node or else the node that followed the removed nodes. When n is nil, the insert values form a new chain.
+
+Example:
+
+	// anchor: 0 --- 1 --- 2 --- 3 --- 4
+	head, removed := anchor.Splice(1, 2, 10, 11, 12)
+	fmt.Println(removed)
+	// Output: [1 2]
+	// New structure:
+	// 0 --- 10 --- 11 --- 12 --- 3 --- 4
+	// ^anchor
+	// ^head
+*/
+func (n *Node[V]) Splice(start, deleteCount int, insert ...V) (*Node[V], []V) {
+	var ins builder[V]
+	for _, v := range insert {
+		ins.add(v)
+	}
+	if n == nil {
+		return ins.head, []V{}
+	}
+
+	nodes := n.Nodes()
+	circular := nodes[len(nodes)-1].Next == n
+	start = min(max(start, 0), len(nodes))
+	deleteCount = min(max(deleteCount, 0), len(nodes)-start)
+	end := start + deleteCount
+
+	var before, after *Node[V]
+	switch {
+	case circular && deleteCount == len(nodes):
+		if ins.head != nil {
+			before, after = ins.tail, ins.head
+		}
+	case circular:
+		before = nodes[(start+len(nodes)-1)%len(nodes)]
+		after = nodes[end%len(nodes)]
+	default:
+		before = n.Prev
+		if start > 0 {
+			before = nodes[start-1]
+		}
+		if end < len(nodes) {
+			after = nodes[end]
+		}
+	}
+
+	removed := make([]V, 0, deleteCount)
+	for _, node := range nodes[start:end] {
+		removed = append(removed, node.Value)
+		node.Next = nil
+		node.Prev = nil
+	}
+
+	first, last := ins.head, ins.tail
+	if first == nil {
+		first, last = after, before
+	}
+	if before != nil {
+		before.Next = first
+	}
+	if first != nil {
+		first.Prev = before
+	}
+	if after != nil {
+		after.Prev = last
+	}
+	if last != nil {
+		last.Next = after
+	}
+
+	anchor := n
+	if start == 0 && deleteCount > 0 {
+		switch {
+		case ins.head != nil:
+			anchor = ins.head
+		case after != nil:
+			anchor = after
+		default:
+			anchor = before
+		}
+	}
+	return anchor.headOrSelf(), removed
+}
+
+/*
+PeekNext returns the values of up to k nodes, starting with the node itself and going "to the right". Fewer values are returned when the tail is reached first. Nothing is changed, which makes this handy for lookahead, e.g. in parsers that walk a list of tokens. Circular chains are handled like VisitByNext() does: every node is included at most once.
+
+Example:
+
+	anchor := lnode.New[int](0)
+	anchor.Append(New[int](1))
+	anchor.Next.Append(New[int](2))
+
+	fmt.Println(anchor.PeekNext(2), anchor.Next.PeekNext(5))
+	// Output: [0 1] [1 2]
+*/
+func (n *Node[V]) PeekNext(k int) []V {
+	return peek(n, k, n.VisitByNext)
+}
+
+/*
+PeekPrev is the mirror of PeekNext: it returns the values of up to k nodes, starting with the node itself and going "to the left". Example:
+
+	anchor := lnode.New[int](0)
+	anchor.Append(New[int](1))
+	anchor.Next.Append(New[int](2))
+
+	fmt.Println(anchor.Tail().PeekPrev(2))
+	// Output: [2 1]
+*/
+func (n *Node[V]) PeekPrev(k int) []V {
+	return peek(n, k, n.VisitByPrev)
+}
+
+// peek collects up to k values using the visitor function.
+func peek[V any](n *Node[V], k int, visit func(fn func(node *Node[V]) bool)) []V {
+	if n == nil || k <= 0 {
+		return nil
+	}
+	out := make([]V, 0, k)
+	visit(func(node *Node[V]) bool {
+		out = append(out, node.Value)
+		return len(out) < k
+	})
+	return out
+}
+
+/*
+RepairPrev rewrites the Prev pointers of a chain so that they match its Next pointers. This fixes chains that were built forward-only, or whose Prev pointers got out of sync after manual splicing. The walk starts at Head(), which follows the Prev pointers; so when these can't be trusted at all, call RepairPrev() on the real head. The head is returned.
+
+When the Next pointers lead back to the start (a circular chain), the start's Prev is set to the last node. When they lead back to some other node that was already seen, the walk stops there: that node can't have two predecessors.
+
+Example:
+
+	anchor := lnode.New[int](0)
+	anchor.Next = lnode.New[int](1)
+	anchor.Next.Next = lnode.New[int](2)
+	// Structure, built via Next only:
+	// 0 --> 1 --> 2
+	// ^anchor
+
+	anchor.RepairPrev()
+	// New structure:
+	// 0 --- 1 --- 2
+	// ^anchor
+*/
+func (n *Node[V]) RepairPrev() *Node[V] {
+	if n == nil {
+		return nil
+	}
+	head := n.headOrSelf()
+	seen := map[*Node[V]]bool{head: true}
+	head.Prev = nil
+	for node := head; node.Next != nil; node = node.Next {
+		if seen[node.Next] {
+			if node.Next == head {
+				head.Prev = node
+			}
+			break
+		}
+		seen[node.Next] = true
+		node.Next.Prev = node
+	}
+	return head
+}
+
+/*
+Bisect splits the chain that starts at the node and extends "to the right" into two halves, and returns the heads of both. The split is made before the node returned by Middle(), so that for an odd number of nodes the right half is the larger one. When the node is the only one, right is nil. Nodes "to the left" of the node stay linked to the left half. This is the split step of a merge sort over bare nodes.
+
+For a circular chain (see function Circular()), nothing is split and nil, nil is returned.
+
+Example:
+
+	anchor := lnode.New[int](0)
+	for i := 1; i < 4; i++ {
+		anchor.Tail().Append(lnode.New[int](i))
+	}
+	// Structure:
+	// 0 --- 1 --- 2 --- 3
+	// ^anchor
+
+	left, right := anchor.Bisect()
+	// New structures:
+	// 0 --- 1
+	// ^left
+	// 2 --- 3
+	// ^right
+*/
+func (n *Node[V]) Bisect() (left *Node[V], right *Node[V]) {
+	if n == nil {
+		return nil, nil
+	}
+	mid := n.Middle()
+	if mid == nil {
+		return nil, nil
+	}
+	if mid == n {
+		return n, nil
+	}
+	mid.Prev.Next = nil
+	mid.Prev = nil
+	return n, mid
+}
+
+/*
+Cut severs the chain just "left" of the node, and returns the heads of both parts: before holds the nodes that preceded the node, after starts with the node itself. Unlike Bisect(), which splits at the middle, Cut splits at a given node. Both parts end in nil pointers. When the node is the head of its chain, nothing changes and before is nil. A circular chain is opened before the node, so that the node becomes its head; since all nodes end up in that one chain, before is nil as well.
+
+Example:
+
+	// anchor: 0 --- 1 --- 2 --- 3
+	before, after := anchor.Next.Next.Cut()
+	// New structures:
+	// 0 --- 1
+	// ^before
+	// 2 --- 3
+	// ^after
+*/
+func (n *Node[V]) Cut() (before *Node[V], after *Node[V]) {
+	if n == nil {
+		return nil, nil
+	}
+	prev := n.Prev
+	if prev == nil {
+		return nil, n
+	}
+	circular := n.RingLen() > 0
+	prev.Next = nil
+	n.Prev = nil
+	if circular {
+		return nil, n
+	}
+	return prev.Head(), n
+}
+
+/*
+SplitN divides the chain that starts at the node and extends "to the right" into parts sub-chains of roughly equal length, by severing the links between them. The heads of the sub-chains are returned. When the length isn't a multiple of parts, the earlier sub-chains get one node more. Nodes "to the left" of the node stay linked to the first sub-chain.
+
+The number of parts is clamped between 1 and the number of nodes, so that no sub-chain is empty. A circular chain is first opened before the node, so that the node heads the first part. When n is nil, nil is returned.
+
+Example:
+
+	anchor := lnode.New[int](0)
+	for i := 1; i < 5; i++ {
+		anchor.Tail().Append(lnode.New[int](i))
+	}
+	parts := anchor.SplitN(2)
+	// Structures:
+	// 0 --- 1 --- 2     parts[0]
+	// 3 --- 4           parts[1]
+*/
+func (n *Node[V]) SplitN(parts int) []*Node[V] {
+	if n == nil {
+		return nil
+	}
+	nodes := n.Nodes()
+	if last := nodes[len(nodes)-1]; last.Next == n {
+		last.Next = nil
+		n.Prev = nil
+	}
+
+	parts = min(max(parts, 1), len(nodes))
+	size, remainder := len(nodes)/parts, len(nodes)%parts
+	heads := make([]*Node[V], 0, parts)
+	for i := 0; i < len(nodes); {
+		head := nodes[i]
+		if head.Prev != nil && len(heads) > 0 {
+			head.Prev.Next = nil
+			head.Prev = nil
+		}
+		heads = append(heads, head)
+		i += size
+		if len(heads) <= remainder {
+			i++
+		}
+	}
+	return heads
+}
+
+/*
+TrimFunc removes nodes from the head of the chain that the node is part of as long as their values satisfy pred, and likewise from the tail, like strings.TrimFunc. Nodes in between are left untouched, even when they match. The removed nodes have their Next and Prev pointers cleared. The new head is returned, or nil when every node was removed.
+
+A circular chain (see function Circular()) has no head or tail: nothing is removed and the node itself is returned.
+
+Example:
+
+	// anchor: 0 --- 0 --- 1 --- 0 --- 2 --- 0
+	isZero := func(v int) bool { return v == 0 }
+	head := anchor.TrimFunc(isZero)
+	// New structure:
+	// 1 --- 0 --- 2
+	// ^head
+*/
+func (n *Node[V]) TrimFunc(pred func(V) bool) *Node[V] {
+	if n == nil {
+		return nil
+	}
+	head, tail := n.Head(), n.Tail()
+	if head == nil || tail == nil {
+		return n
+	}
+
+	for pred(head.Value) {
+		if head == tail {
+			head.detach()
+			return nil
+		}
+		next := head.Next
+		head.detach()
+		head = next
+	}
+	for tail != head && pred(tail.Value) {
+		prev := tail.Prev
+		tail.detach()
+		tail = prev
+	}
+	return head
+}
+
+// Reset clears the node: its value is set to the zero value of V, and its Next and Prev pointers to nil. The neighbors are not updated; use Delete() first when the node is part of a chain.
+func (n *Node[V]) Reset() {
+	var zero V
+	n.Value = zero
+	n.Next = nil
+	n.Prev = nil
+}
+
+/*
+ReplaceWhere sets the value of every node whose value satisfies pred to newValue. The chain is modified in place, starting at the node and going "to the right"; circular chains are handled like VisitByNext() does. Example:
+
+	// anchor: 1 --- -2 --- 3 --- -4
+	anchor.ReplaceWhere(func(v int) bool { return v < 0 }, 0)
+	// New values:
+	// 1 --- 0 --- 3 --- 0
+*/
+func (n *Node[V]) ReplaceWhere(pred func(V) bool, newValue V) {
+	n.ReplaceWhereFunc(pred, func(V) V { return newValue })
+}
+
+/*
+ReplaceWhereFunc generalizes ReplaceWhere(): the value of every node whose value satisfies pred is replaced by the result of fn, applied to that value. Example:
+
+	// anchor: 1 --- -2 --- 3 --- -4
+	anchor.ReplaceWhereFunc(func(v int) bool { return v < 0 }, func(v int) int { return -v })
+	// New values:
+	// 1 --- 2 --- 3 --- 4
+*/
+func (n *Node[V]) ReplaceWhereFunc(pred func(V) bool, fn func(V) V) {
+	n.Each(func(node *Node[V]) {
+		if pred(node.Value) {
+			node.Value = fn(node.Value)
+		}
+	})
+}
+
+/*
+RemoveFirst deletes the first node, starting at the node itself and going "to the right", whose value satisfies pred. The removed node has its Next and Prev pointers cleared. Nothing is removed when no node matches.
+
+RemoveFirst returns the head of the chain, which differs from before when the head itself was removed; nil is returned when no nodes remain. A circular chain has no head; in that case the node itself is returned, or when it was removed, the node that followed it.
+
+Example:
+
+	// anchor: 1 --- 2 --- 3 --- 4
+	head := anchor.RemoveFirst(func(v int) bool { return v%2 == 0 })
+	// New structure:
+	// 1 --- 3 --- 4
+	// ^head
+*/
+func (n *Node[V]) RemoveFirst(pred func(V) bool) *Node[V] {
+	anchor := n
+	if found := n.Find(pred); found != nil {
+		rest := found.DeleteN(1)
+		if found == n {
+			anchor = rest
+		}
+	}
+	return anchor.headOrSelf()
+}
+
+/*
+FindMoveToFront searches the chain that the node is part of, from its head, for the first node whose value satisfies pred, and moves it to the front of the chain. This is the move-to-front heuristic of self-organizing lists: frequently accessed values drift towards the head, so that looking them up gets cheaper, as in simple caches. The new head is returned. When no node matches, the chain is left untouched and its head is returned. In a circular chain, which has no head, the search starts at the node itself, and a matching node is moved just before it and returned as the new starting point.
+
+Example:
+
+	// anchor: 1 --- 2 --- 3 --- 4
+	head := anchor.FindMoveToFront(func(v int) bool { return v == 3 })
+	// New structure:
+	// 3 --- 1 --- 2 --- 4
+	// ^head ^anchor
+*/
+func (n *Node[V]) FindMoveToFront(pred func(V) bool) *Node[V] {
+	head := n.headOrSelf()
+	found := head.Find(pred)
+	if found == nil || found == head {
+		return head
+	}
+	found.MoveBefore(head)
+	return found
+}
+
+/*
+PushFront creates a node holding value, inserts it before the head of the chain that the node is part of, and returns it as the new head. Finding the head takes O(N) time, N being the number of nodes "to the left"; when building long chains, keep a pointer to the head instead. For a nil node, a new single-node chain is returned. In a circular chain, which has no head, the new node is inserted just before the node itself.
+
+Example:
+
+	anchor := lnode.New[int](1)
+	anchor.Append(lnode.New[int](2))
+	head := anchor.Next.PushFront(0)
+	// New structure:
+	// 0 --- 1 --- 2
+	// ^head ^anchor
+*/
+func (n *Node[V]) PushFront(value V) *Node[V] {
+	node := New[V](value)
+	if n != nil {
+		n.headOrSelf().Prepend(node)
+	}
+	return node
+}
+
+/*
+PushBack creates a node holding value, inserts it after the tail of the chain that the node is part of, and returns it as the new tail. Finding the tail takes O(N) time, N being the number of nodes "to the right"; when building long chains, keep a pointer to the tail instead (see BuildChain()). For a nil node, a new single-node chain is returned. In a circular chain, which has no tail, the new node is inserted just after the node itself.
+
+Example:
+
+	anchor := lnode.New[int](0)
+	anchor.Append(lnode.New[int](1))
+	tail := anchor.PushBack(2)
+	// New structure:
+	// 0 --- 1 --- 2
+	// ^anchor     ^tail
+*/
+func (n *Node[V]) PushBack(value V) *Node[V] {
+	node := New[V](value)
+	if n != nil {
+		tail := n.Tail()
+		if tail == nil {
+			tail = n
+		}
+		tail.Append(node)
+	}
+	return node
+}
+
+/*
+SwapWithNext exchanges the positions of the node and its Next node, by relinking them and their neighbors. Nothing happens when there is no Next node. This is the basic step of e.g. a bubble sort over the links. Example:
+
+	// anchor: 0 --- 1 --- 2 --- 3
+	anchor.Next.SwapWithNext()
+	// New structure:
+	// 0 --- 2 --- 1 --- 3
+*/
+func (n *Node[V]) SwapWithNext() {
+	a, b := n, n.Next
+	// In a ring of one or two nodes, swapping changes nothing.
+	if b == nil || b == a || b.Next == a {
+		return
+	}
+	before, after := a.Prev, b.Next
+	if before != nil {
+		before.Next = b
+	}
+	b.Prev = before
+	b.Next = a
+	a.Prev = b
+	a.Next = after
+	if after != nil {
+		after.Prev = a
+	}
+}
+
+/*
+SwapWithPrev is the mirror of SwapWithNext(): it exchanges the positions of the node and its Prev node. Nothing happens when there is no Prev node. Example:
+
+	// anchor: 0 --- 1 --- 2 --- 3
+	anchor.Next.SwapWithPrev()
+	// New structure:
+	// 1 --- 0 --- 2 --- 3
+*/
+func (n *Node[V]) SwapWithPrev() {
+	if n.Prev != nil {
+		n.Prev.SwapWithNext()
+	}
+}
+
+/*
+SwapEnds exchanges the positions of the head and the tail of the chain that the node is part of, by relinking them; their values stay with the nodes. The nodes in between stay where they are. Nothing happens for a single node, or for a circular chain (see function Circular()), which has no ends. Finding the ends takes O(N) time.
+
+Example:
+
+	// anchor: 0 --- 1 --- 2 --- 3
+	anchor.SwapEnds()
+	// New structure:
+	// 3 --- 1 --- 2 --- 0
+	//                   ^anchor
+*/
+func (n *Node[V]) SwapEnds() {
+	head, tail := n.Ends()
+	if head == nil || head == tail {
+		return
+	}
+	if head.Next == tail {
+		head.Prev, head.Next = tail, nil
+		tail.Prev, tail.Next = nil, head
+		return
+	}
+	second, penultimate := head.Next, tail.Prev
+	tail.Prev, tail.Next = nil, second
+	second.Prev = tail
+	head.Prev, head.Next = penultimate, nil
+	penultimate.Next = head
+}
+
+/*
+ShiftValues rotates the values of the chain that the node is part of by k positions, leaving all nodes and pointers where they are. This keeps external references to specific nodes valid, while the contents shift. For positive k, values move "to the right": the value at position i moves to position i+k, and values shifted past the tail wrap around to the head. Negative k shifts "to the left".
+
+For a linear chain, positions count from Head(). For a circular chain, every node has a next one, so values simply move k steps along the ring.
+
+Example:
+
+	// anchor: 0 --- 1 --- 2 --- 3
+	anchor.ShiftValues(1)
+	// New values:
+	// 3 --- 0 --- 1 --- 2
+	// ^anchor
+*/
+func (n *Node[V]) ShiftValues(k int) {
+	nodes := n.headOrSelf().Nodes()
+	if len(nodes) == 0 {
+		return
+	}
+	values := make([]V, len(nodes))
+	for i, node := range nodes {
+		values[i] = node.Value
+	}
+	k %= len(nodes)
+	for i, v := range values {
+		nodes[(i+k+len(nodes))%len(nodes)].Value = v
+	}
+}
+
+// MaxStabilizePasses is the number of passes after which Stabilize() gives up.
+const MaxStabilizePasses = 1000
+
+/*
+Stabilize applies fn to the value of every node, starting at the node and going "to the right", in passes, until a pass makes no changes. fn modifies the value in place and returns whether it changed anything. This suits iterative relaxation or propagation, e.g. smoothing a sequence until it's stable. Stabilize returns the number of passes, including the final one without changes; for a nil node, that is 0. To guard against a fn that never settles, Stabilize stops after MaxStabilizePasses passes, so a result of MaxStabilizePasses means that the values may not be stable. Circular chains are handled like VisitByNext() does: every node is visited once per pass.
+
+Example:
+
+	// anchor: 20 --- 4 --- 7
+	passes := anchor.Stabilize(func(v *int) bool {
+		if *v > 5 {
+			*v /= 2
+			return true
+		}
+		return false
+	})
+	fmt.Println(passes)
+	// Output: 3
+	// New values:
+	// 5 --- 4 --- 3
+	// ^anchor
+*/
+func (n *Node[V]) Stabilize(fn func(*V) bool) int {
+	if n == nil {
+		return 0
+	}
+	passes := 0
+	for changed := true; changed && passes < MaxStabilizePasses; passes++ {
+		changed = false
+		n.Each(func(node *Node[V]) {
+			if fn(&node.Value) {
+				changed = true
+			}
+		})
+	}
+	return passes
+}
+
+/*
+InsertBetween inserts node between the adjacent nodes a and b, rewiring the pointers of all three. It returns an error, and changes nothing, when a and b aren't neighbors (i.e., a.Next isn't b), or when node is nil or already part of a chain (see AppendSafe()). This catches mistakes where callers believe two nodes are neighbors while they aren't. Example:
+
+	// anchor: 0 --- 2
+	err := lnode.InsertBetween(anchor, anchor.Next, lnode.New[int](1))
+	// New structure:
+	// 0 --- 1 --- 2
+
+	err = lnode.InsertBetween(anchor, anchor.Tail(), lnode.New[int](9))
+	// err is not nil: anchor and its tail aren't adjacent
+*/
+func InsertBetween[V any](a, b *Node[V], node *Node[V]) error {
+	if a == nil || b == nil || a.Next != b || b.Prev != a {
+		return errors.New("nodes are not adjacent")
+	}
+	if err := checkUnlinked(a, node); err != nil {
+		return err
+	}
+	a.Append(node)
+	return nil
+}
+
+/*
+LenUpTo returns the number of nodes, starting at the node and going "to the right", but stops counting at limit. This bounds the cost to O(limit), which makes it cheap to check whether a possibly long chain exceeds some size. Circular chains are handled like VisitByNext() does: every node is counted once. Example:
+
+	// anchor: 0 --- 1 --- 2 --- 3
+	fmt.Println(anchor.LenUpTo(10), anchor.LenUpTo(2))
+	// Output: 4 2
+*/
+func (n *Node[V]) LenUpTo(limit int) int {
+	count := 0
+	if limit <= 0 {
+		return count
+	}
+	n.VisitByNext(func(*Node[V]) bool {
+		count++
+		return count < limit
+	})
+	return count
+}
+
+/*
+Truncate keeps at most maxLen nodes, starting at the node and going "to the right", and cuts off the rest: the last kept node becomes the tail. This caps a growing chain. The nodes that are cut off form a separate linear chain, which is discarded unless the caller holds on to it. Nodes "to the left" of the node are kept. When the chain already is short enough, nothing changes. A maxLen less than 1 is treated as 1. The node itself is returned.
+
+A circular chain (see function Circular()) that holds more than maxLen nodes is opened up, so that the node becomes the head of the kept nodes.
+
+Example:
+
+	// anchor: 0 --- 1 --- 2 --- 3 --- 4
+	anchor.Truncate(3)
+	// New structure:
+	// 0 --- 1 --- 2
+	// ^anchor
+*/
+func (n *Node[V]) Truncate(maxLen int) *Node[V] {
+	if n == nil {
+		return nil
+	}
+	circular := n.RingLen() > 0
+	last := n
+	for range max(maxLen, 1) - 1 {
+		if last = last.advance(n); last == nil {
+			return n
+		}
+	}
+	rest := last.advance(n)
+	if rest == nil {
+		return n
+	}
+	last.Next = nil
+	rest.Prev = nil
+	if circular {
+		n.Prev.Next = nil
+		n.Prev = nil
+	}
+	return n
+}
+
+/*
+RotateRange rotates the segment of count nodes that starts at the node, going "to the right", by k positions in place: the first k nodes of the segment are moved, in order, behind its last node. A negative k rotates the other way. The nodes before and after the segment stay where they are and are linked to the new ends of the segment. count is clamped to the number of available nodes, and k is reduced modulo count. The new first node of the segment is returned; when nothing changes, that is the node itself.
+
+When the segment spans a whole circular chain (see function Circular()), no pointers change: the node that is k steps further becomes the start.
+
+Example:
+
+	// anchor: 9 --- 0 --- 1 --- 2 --- 3 --- 9
+	//               ^start
+	first := start.RotateRange(4, 1)
+	// New structure:
+	// 9 --- 1 --- 2 --- 3 --- 0 --- 9
+	//       ^first            ^start
+*/
+func (n *Node[V]) RotateRange(count, k int) *Node[V] {
+	var segment []*Node[V]
+	for node := n; node != nil && len(segment) < count; node = node.advance(n) {
+		segment = append(segment, node)
+	}
+	if len(segment) < 2 {
+		return n
+	}
+	k = (k%len(segment) + len(segment)) % len(segment)
+	if k == 0 {
+		return n
+	}
+	before, after := n.Prev, segment[len(segment)-1].Next
+	if after == n {
+		return segment[k]
+	}
+
+	var b builder[V]
+	for _, node := range append(segment[k:], segment[:k]...) {
+		b.link(node)
+	}
+	b.head.Prev = before
+	if before != nil {
+		before.Next = b.head
+	}
+	b.tail.Next = after
+	if after != nil {
+		after.Prev = b.tail
+	}
+	return b.head
+}
+
+/*
+AppendBounded appends node "right" of the current node, like Append(), but only when the chain that the node is part of then holds at most maxLen nodes. Otherwise an error is returned and nothing is changed. This suits fixed-capacity buffers built on bare nodes. The node to append is counted as one; it should not be part of another chain.
+
+The length check counts the nodes in both directions, but stops at maxLen, so it takes O(maxLen) time however long the chain is.
+
+Example:
+
+	anchor := lnode.New[int](0)
+	err := anchor.AppendBounded(lnode.New[int](1), 2) // ok, 2 nodes
+	err = anchor.AppendBounded(lnode.New[int](2), 2)  // error, would be 3 nodes
+*/
+func (n *Node[V]) AppendBounded(node *Node[V], maxLen int) error {
+	if n.chainLenUpTo(maxLen)+1 > maxLen {
+		return fmt.Errorf("appending would exceed the maximum length of %d nodes", maxLen)
+	}
+	n.Append(node)
+	return nil
+}
+
+// chainLenUpTo counts the nodes of the chain that n is part of, in both directions, but stops counting at limit.
+func (n *Node[V]) chainLenUpTo(limit int) int {
+	count := 0
+	for node := n; node != nil && count < limit; {
+		count++
+		node = node.Next
+		if node == n {
+			// Circular, every node is counted.
+			return count
+		}
+	}
+	for node := n.Prev; node != nil && count < limit; node = node.Prev {
+		count++
+	}
+	return count
+}
+
+/*
+Stride returns a new chain holding every step-th value of the chain, starting with the node's own value and going "to the right": the values at offsets 0, step, 2*step and so on. This is useful to downsample a sequence. A step less than 1 is treated as 1, which copies the chain. Nil is returned only when the node is nil. Circular chains are handled like VisitByNext() does: every node is considered once.
+
+Example:
+
+	// anchor: 0 --- 1 --- 2 --- 3 --- 4
+	sampled := anchor.Stride(2)
+	// Structure of the new chain:
+	// 0 --- 2 --- 4
+	// ^sampled
+*/
+func (n *Node[V]) Stride(step int) *Node[V] {
+	step = max(step, 1)
+	var out builder[V]
+	i := 0
+	n.EachValue(func(v V) {
+		if i%step == 0 {
+			out.add(v)
+		}
+		i++
+	})
+	return out.head
+}
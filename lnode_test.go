@@ -1,6 +1,10 @@
 package lnode
 
-import "testing"
+import (
+	"errors"
+	"slices"
+	"testing"
+)
 
 func TestAppend(t *testing.T) {
 	start := New[int](0)
@@ -138,3 +142,1369 @@ func TestVisitedAndHeadTail(t *testing.T) {
 		t.Errorf("Before closing the loop: anchor.Head() = %v, want nil", tl)
 	}
 }
+
+// chainOf links fresh nodes holding values into a chain and returns the nodes in order.
+func chainOf[V any](values ...V) []*Node[V] {
+	var nodes []*Node[V]
+	for i, v := range values {
+		nodes = append(nodes, New[V](v))
+		if i > 0 {
+			nodes[i-1].Append(nodes[i])
+		}
+	}
+	return nodes
+}
+
+// valuesOf collects the values from n rightward.
+func valuesOf[V any](n *Node[V]) []V {
+	var out []V
+	n.VisitByNext(func(node *Node[V]) bool {
+		out = append(out, node.Value)
+		return true
+	})
+	return out
+}
+
+// wellLinked returns true when every node from n rightward is pointed back at by its Next.
+func wellLinked[V any](n *Node[V]) bool {
+	ok := true
+	n.VisitByNext(func(node *Node[V]) bool {
+		if node.Next != nil && node.Next.Prev != node {
+			ok = false
+		}
+		return ok
+	})
+	return ok
+}
+
+// closeLoop turns the chain of nodes into a circular one.
+func closeLoop[V any](nodes []*Node[V]) {
+	first, last := nodes[0], nodes[len(nodes)-1]
+	last.Next = first
+	first.Prev = last
+}
+
+func TestDeleteN(t *testing.T) {
+	for _, test := range []struct {
+		desc       string
+		start      int
+		count      int
+		wantValues []int
+		wantNext   int
+	}{
+		{desc: "middle", start: 1, count: 2, wantValues: []int{0, 3, 4}, wantNext: 3},
+		{desc: "head", start: 0, count: 2, wantValues: []int{2, 3, 4}, wantNext: 2},
+		{desc: "up to tail", start: 3, count: 2, wantValues: []int{0, 1, 2}, wantNext: 2},
+		{desc: "clamped", start: 2, count: 100, wantValues: []int{0, 1}, wantNext: 1},
+		{desc: "zero count", start: 2, count: 0, wantValues: []int{0, 1, 2, 3, 4}, wantNext: 2},
+	} {
+		nodes := chainOf(0, 1, 2, 3, 4)
+		got := nodes[test.start].DeleteN(test.count)
+		if got == nil || got.Value != test.wantNext {
+			t.Errorf("DeleteN: %s: returned %v, want node with value %d", test.desc, got, test.wantNext)
+			continue
+		}
+		if vals := valuesOf(got.Head()); !slices.Equal(vals, test.wantValues) {
+			t.Errorf("DeleteN: %s: remaining values %v, want %v", test.desc, vals, test.wantValues)
+		}
+		for i := test.start; i < test.start+test.count && i < len(nodes); i++ {
+			if nodes[i].Next != nil || nodes[i].Prev != nil {
+				t.Errorf("DeleteN: %s: deleted node %d still has pointers", test.desc, i)
+			}
+		}
+	}
+
+	// Delete everything
+	nodes := chainOf(0, 1, 2, 3, 4)
+	if got := nodes[0].DeleteN(5); got != nil {
+		t.Errorf("DeleteN: whole list: returned %v, want nil", got)
+	}
+
+	// Delete an entire circular chain
+	nodes = chainOf(0, 1, 2, 3, 4)
+	closeLoop(nodes)
+	if got := nodes[2].DeleteN(10); got != nil {
+		t.Errorf("DeleteN: whole circular chain: returned %v, want nil", got)
+	}
+
+	// Delete part of a circular chain
+	nodes = chainOf(0, 1, 2, 3, 4)
+	closeLoop(nodes)
+	got := nodes[3].DeleteN(3)
+	if got != nodes[1] || nodes[2].Next != nodes[1] || nodes[1].Prev != nodes[2] {
+		t.Errorf("DeleteN: partial circular chain: returned %v, want %v and a repaired ring", got, nodes[1])
+	}
+}
+
+func TestMoveBeforeAfter(t *testing.T) {
+	for _, test := range []struct {
+		desc string
+		move func(nodes []*Node[int])
+		want []int
+	}{
+		{
+			desc: "move tail before head",
+			move: func(nodes []*Node[int]) { nodes[4].MoveBefore(nodes[0]) },
+			want: []int{4, 0, 1, 2, 3},
+		},
+		{
+			desc: "move head after tail",
+			move: func(nodes []*Node[int]) { nodes[0].MoveAfter(nodes[4]) },
+			want: []int{1, 2, 3, 4, 0},
+		},
+		{
+			desc: "move middle before middle",
+			move: func(nodes []*Node[int]) { nodes[3].MoveBefore(nodes[1]) },
+			want: []int{0, 3, 1, 2, 4},
+		},
+		{
+			desc: "move middle after middle",
+			move: func(nodes []*Node[int]) { nodes[1].MoveAfter(nodes[3]) },
+			want: []int{0, 2, 3, 1, 4},
+		},
+		{
+			desc: "swap neighbors via MoveBefore",
+			move: func(nodes []*Node[int]) { nodes[2].MoveBefore(nodes[1]) },
+			want: []int{0, 2, 1, 3, 4},
+		},
+		{
+			desc: "already before",
+			move: func(nodes []*Node[int]) { nodes[1].MoveBefore(nodes[2]) },
+			want: []int{0, 1, 2, 3, 4},
+		},
+		{
+			desc: "already after",
+			move: func(nodes []*Node[int]) { nodes[2].MoveAfter(nodes[1]) },
+			want: []int{0, 1, 2, 3, 4},
+		},
+		{
+			desc: "same node",
+			move: func(nodes []*Node[int]) { nodes[2].MoveAfter(nodes[2]); nodes[2].MoveBefore(nodes[2]) },
+			want: []int{0, 1, 2, 3, 4},
+		},
+	} {
+		nodes := chainOf(0, 1, 2, 3, 4)
+		test.move(nodes)
+		head := nodes[0].Head()
+		if got := valuesOf(head); !slices.Equal(got, test.want) {
+			t.Errorf("%s: got %v, want %v", test.desc, got, test.want)
+		}
+		if !wellLinked(head) {
+			t.Errorf("%s: Prev pointers are inconsistent", test.desc)
+		}
+		if tail := head.Tail(); tail.Next != nil || tail.Value != test.want[len(test.want)-1] {
+			t.Errorf("%s: tail = %v, want value %d", test.desc, tail, test.want[len(test.want)-1])
+		}
+	}
+}
+
+func TestAppendPrependSelf(t *testing.T) {
+	single := New[int](0)
+	single.Append(single)
+	single.Prepend(single)
+	if single.Next != nil || single.Prev != nil {
+		t.Errorf("Append/Prepend of node to itself: got a self-loop, want no change")
+	}
+
+	for _, test := range []struct {
+		desc string
+		op   func(nodes []*Node[int])
+	}{
+		{desc: "Append(Next)", op: func(nodes []*Node[int]) { nodes[1].Append(nodes[2]) }},
+		{desc: "Append(Prev)", op: func(nodes []*Node[int]) { nodes[1].Append(nodes[0]) }},
+		{desc: "Prepend(Prev)", op: func(nodes []*Node[int]) { nodes[1].Prepend(nodes[0]) }},
+		{desc: "Prepend(Next)", op: func(nodes []*Node[int]) { nodes[1].Prepend(nodes[2]) }},
+	} {
+		nodes := chainOf(0, 1, 2)
+		test.op(nodes)
+		if got := valuesOf(nodes[0]); !slices.Equal(got, []int{0, 1, 2}) || nodes[0].Prev != nil || !wellLinked(nodes[0]) {
+			t.Errorf("%s of adjacent node: got %v, want unchanged [0 1 2]", test.desc, got)
+		}
+	}
+
+	// A nil node panics, wherever it's offered.
+	for _, test := range []struct {
+		desc string
+		op   func(nodes []*Node[int])
+	}{
+		{desc: "tail.Append(nil)", op: func(nodes []*Node[int]) { nodes[2].Append(nil) }},
+		{desc: "mid.Append(nil)", op: func(nodes []*Node[int]) { nodes[1].Append(nil) }},
+		{desc: "head.Prepend(nil)", op: func(nodes []*Node[int]) { nodes[0].Prepend(nil) }},
+		{desc: "mid.Prepend(nil)", op: func(nodes []*Node[int]) { nodes[1].Prepend(nil) }},
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s: no panic, want one", test.desc)
+				}
+			}()
+			test.op(chainOf(0, 1, 2))
+		}()
+	}
+
+	if err := single.AppendSafe(single); err == nil || errors.Is(err, ErrLinked) {
+		t.Errorf("AppendSafe(itself) = %v, want self-insertion error", err)
+	}
+	if err := single.PrependSafe(single); err == nil || errors.Is(err, ErrLinked) {
+		t.Errorf("PrependSafe(itself) = %v, want self-insertion error", err)
+	}
+	if single.Next != nil || single.Prev != nil {
+		t.Errorf("AppendSafe/PrependSafe of node to itself: got a self-loop, want no change")
+	}
+}
+
+func TestAppendPrependSafe(t *testing.T) {
+	anchor := New[int](0)
+	if err := anchor.AppendSafe(New[int](1)); err != nil {
+		t.Errorf("AppendSafe(fresh node) = %v, want nil", err)
+	}
+	if err := anchor.PrependSafe(New[int](-1)); err != nil {
+		t.Errorf("PrependSafe(fresh node) = %v, want nil", err)
+	}
+	want := []int{-1, 0, 1}
+	if got := valuesOf(anchor.Head()); !slices.Equal(got, want) {
+		t.Errorf("After AppendSafe/PrependSafe: got %v, want %v", got, want)
+	}
+
+	other := chainOf(10, 11, 12)
+	for _, node := range other {
+		if err := anchor.AppendSafe(node); !errors.Is(err, ErrLinked) {
+			t.Errorf("AppendSafe(linked node %d) = %v, want ErrLinked", node.Value, err)
+		}
+		if err := anchor.PrependSafe(node); !errors.Is(err, ErrLinked) {
+			t.Errorf("PrependSafe(linked node %d) = %v, want ErrLinked", node.Value, err)
+		}
+	}
+	if err := anchor.AppendSafe(nil); err == nil {
+		t.Errorf("AppendSafe(nil) = nil, want error")
+	}
+	if err := anchor.PrependSafe(nil); err == nil {
+		t.Errorf("PrependSafe(nil) = nil, want error")
+	}
+
+	// Neither chain was touched.
+	if got := valuesOf(anchor.Head()); !slices.Equal(got, want) {
+		t.Errorf("After refused inserts: got %v, want %v", got, want)
+	}
+	if got, want := valuesOf(other[0]), []int{10, 11, 12}; !slices.Equal(got, want) {
+		t.Errorf("Other chain after refused inserts: got %v, want %v", got, want)
+	}
+}
+
+func TestMiddle(t *testing.T) {
+	var empty *Node[int]
+	if got := empty.Middle(); got != nil {
+		t.Errorf("Middle() of nil = %v, want nil", got)
+	}
+	for length := 1; length <= 6; length++ {
+		var values []int
+		for i := range length {
+			values = append(values, i)
+		}
+		nodes := chainOf(values...)
+		if got := nodes[0].Middle(); got != nodes[length/2] {
+			t.Errorf("Middle() of length %d = %v, want %v", length, got, nodes[length/2])
+		}
+	}
+
+	nodes := chainOf(0, 1, 2, 3, 4)
+	closeLoop(nodes)
+	if got := nodes[0].Middle(); got != nil {
+		t.Errorf("Middle() of circular chain = %v, want nil", got)
+	}
+
+	// A chain that loops back onto a later node: 0 - 1 - 2 - 3 - 4 - back to 2
+	nodes = chainOf(0, 1, 2, 3, 4)
+	nodes[4].Next = nodes[2]
+	if got := nodes[0].Middle(); got != nil {
+		t.Errorf("Middle() of rho-shaped chain = %v, want nil", got)
+	}
+}
+
+func TestFind(t *testing.T) {
+	nodes := chainOf(1, 2, 3, 4)
+	for _, test := range []struct {
+		desc  string
+		start int
+		pred  func(int) bool
+		want  *Node[int]
+	}{
+		{desc: "first", start: 0, pred: func(v int) bool { return v > 0 }, want: nodes[0]},
+		{desc: "later", start: 0, pred: func(v int) bool { return v > 2 }, want: nodes[2]},
+		{desc: "from the middle", start: 2, pred: func(v int) bool { return v < 2 }, want: nil},
+		{desc: "none", start: 0, pred: func(v int) bool { return v > 10 }, want: nil},
+	} {
+		if got := nodes[test.start].Find(test.pred); got != test.want {
+			t.Errorf("Find: %s: got %v, want %v", test.desc, got, test.want)
+		}
+	}
+
+	closeLoop(nodes)
+	if got := nodes[2].Find(func(v int) bool { return v < 2 }); got != nodes[0] {
+		t.Errorf("Find in circular chain: got %v, want %v", got, nodes[0])
+	}
+	if got := nodes[2].Find(func(v int) bool { return v > 10 }); got != nil {
+		t.Errorf("Find in circular chain: got %v, want nil", got)
+	}
+}
+
+func TestVisitBatches(t *testing.T) {
+	nodes := chainOf(0, 1, 2, 3, 4, 5, 6)
+	for _, test := range []struct {
+		desc      string
+		batchSize int
+		stopAfter int
+		want      [][]int
+	}{
+		{desc: "partial last batch", batchSize: 3, want: [][]int{{0, 1, 2}, {3, 4, 5}, {6}}},
+		{desc: "one batch", batchSize: 10, want: [][]int{{0, 1, 2, 3, 4, 5, 6}}},
+		{desc: "zero size", batchSize: 0, stopAfter: 2, want: [][]int{{0}, {1}}},
+		{desc: "stop early", batchSize: 2, stopAfter: 2, want: [][]int{{0, 1}, {2, 3}}},
+	} {
+		var got [][]int
+		nodes[0].VisitBatches(test.batchSize, func(batch []int) bool {
+			got = append(got, slices.Clone(batch))
+			return test.stopAfter == 0 || len(got) < test.stopAfter
+		})
+		if !slices.EqualFunc(got, test.want, slices.Equal) {
+			t.Errorf("VisitBatches: %s: got %v, want %v", test.desc, got, test.want)
+		}
+	}
+
+	closeLoop(nodes)
+	count := 0
+	nodes[3].VisitBatches(4, func(batch []int) bool {
+		count += len(batch)
+		return true
+	})
+	if count != len(nodes) {
+		t.Errorf("VisitBatches on circular chain: visited %d values, want %d", count, len(nodes))
+	}
+}
+
+func benchmarkChain(size int) *Node[int] {
+	values := make([]int, size)
+	for i := range values {
+		values[i] = i
+	}
+	return chainOf(values...)[0]
+}
+
+func BenchmarkVisitByNext(b *testing.B) {
+	anchor := benchmarkChain(1000)
+	for b.Loop() {
+		sum := 0
+		anchor.VisitByNext(func(node *Node[int]) bool {
+			sum += node.Value
+			return true
+		})
+	}
+}
+
+func BenchmarkVisitBatches(b *testing.B) {
+	anchor := benchmarkChain(1000)
+	for b.Loop() {
+		sum := 0
+		anchor.VisitBatches(256, func(batch []int) bool {
+			for _, v := range batch {
+				sum += v
+			}
+			return true
+		})
+	}
+}
+
+func TestDeleteCopy(t *testing.T) {
+	for _, test := range []struct {
+		desc   string
+		delete int
+		want   []int
+	}{
+		{desc: "head", delete: 0, want: []int{1, 2, 3}},
+		{desc: "middle", delete: 2, want: []int{0, 1, 3}},
+		{desc: "tail", delete: 3, want: []int{0, 1, 2}},
+	} {
+		nodes := chainOf(0, 1, 2, 3)
+		got := nodes[test.delete].DeleteCopy()
+		if vals := valuesOf(got); !slices.Equal(vals, test.want) {
+			t.Errorf("DeleteCopy: %s: got %v, want %v", test.desc, vals, test.want)
+		}
+		if got.Prev != nil || !wellLinked(got) {
+			t.Errorf("DeleteCopy: %s: copy is malformed", test.desc)
+		}
+		if vals, want := valuesOf(nodes[0]), []int{0, 1, 2, 3}; !slices.Equal(vals, want) {
+			t.Errorf("DeleteCopy: %s: original changed to %v, want %v", test.desc, vals, want)
+		}
+		for node := got; node != nil; node = node.Next {
+			if slices.Contains(nodes, node) {
+				t.Errorf("DeleteCopy: %s: copy shares node %v with the original", test.desc, node)
+			}
+		}
+	}
+
+	if got := New[int](0).DeleteCopy(); got != nil {
+		t.Errorf("DeleteCopy of single node = %v, want nil", got)
+	}
+
+	nodes := chainOf(0, 1, 2, 3)
+	closeLoop(nodes)
+	got := nodes[1].DeleteCopy()
+	if vals, want := valuesOf(got), []int{2, 3, 0}; !slices.Equal(vals, want) || !got.Circular() {
+		t.Errorf("DeleteCopy of circular chain: got %v (circular: %v), want circular %v", vals, got.Circular(), want)
+	}
+	if vals, want := valuesOf(nodes[0]), []int{0, 1, 2, 3}; !slices.Equal(vals, want) {
+		t.Errorf("DeleteCopy of circular chain: original changed to %v, want %v", vals, want)
+	}
+}
+
+func TestSplice(t *testing.T) {
+	for _, test := range []struct {
+		desc        string
+		start, del  int
+		insert      []int
+		wantChain   []int
+		wantRemoved []int
+	}{
+		{desc: "replace in middle", start: 1, del: 2, insert: []int{10, 11, 12}, wantChain: []int{0, 10, 11, 12, 3, 4}, wantRemoved: []int{1, 2}},
+		{desc: "replace head", start: 0, del: 1, insert: []int{10}, wantChain: []int{10, 1, 2, 3, 4}, wantRemoved: []int{0}},
+		{desc: "insert at head", start: 0, del: 0, insert: []int{10}, wantChain: []int{10, 0, 1, 2, 3, 4}, wantRemoved: []int{}},
+		{desc: "remove only", start: 3, del: 1, wantChain: []int{0, 1, 2, 4}, wantRemoved: []int{3}},
+		{desc: "count exceeds nodes", start: 3, del: 10, insert: []int{10}, wantChain: []int{0, 1, 2, 10}, wantRemoved: []int{3, 4}},
+		{desc: "start beyond tail", start: 9, del: 1, insert: []int{10}, wantChain: []int{0, 1, 2, 3, 4, 10}, wantRemoved: []int{}},
+		{desc: "negative arguments", start: -1, del: -1, insert: []int{10}, wantChain: []int{10, 0, 1, 2, 3, 4}, wantRemoved: []int{}},
+		{desc: "remove all", start: 0, del: 5, wantChain: nil, wantRemoved: []int{0, 1, 2, 3, 4}},
+	} {
+		nodes := chainOf(0, 1, 2, 3, 4)
+		head, removed := nodes[0].Splice(test.start, test.del, test.insert...)
+		if got := valuesOf(head); !slices.Equal(got, test.wantChain) || !wellLinked(head) || (head != nil && head.Prev != nil) {
+			t.Errorf("Splice: %s: got chain %v, want %v", test.desc, got, test.wantChain)
+		}
+		if !slices.Equal(removed, test.wantRemoved) {
+			t.Errorf("Splice: %s: got removed %v, want %v", test.desc, removed, test.wantRemoved)
+		}
+		for _, node := range nodes {
+			if slices.Contains(test.wantRemoved, node.Value) && (node.Next != nil || node.Prev != nil) {
+				t.Errorf("Splice: %s: removed node %d still linked", test.desc, node.Value)
+			}
+		}
+	}
+
+	// Relative to a node in the middle: the nodes to its left stay
+	nodes := chainOf(0, 1, 2, 3)
+	head, removed := nodes[2].Splice(0, 1, 9)
+	if got := valuesOf(head); !slices.Equal(got, []int{0, 1, 9, 3}) || !slices.Equal(removed, []int{2}) {
+		t.Errorf("Splice from middle: got %v, removed %v, want [0 1 9 3], removed [2]", got, removed)
+	}
+
+	// Circular chains stay circular
+	nodes = chainOf(0, 1, 2, 3)
+	closeLoop(nodes)
+	head, removed = nodes[0].Splice(0, 2, 9)
+	if got := valuesOf(head); !slices.Equal(got, []int{9, 2, 3}) || head.RingLen() != 3 || !slices.Equal(removed, []int{0, 1}) {
+		t.Errorf("Splice of circular chain: got %v, removed %v, want ring [9 2 3], removed [0 1]", got, removed)
+	}
+	head, removed = head.Splice(0, 5, 7, 8)
+	if got := valuesOf(head); !slices.Equal(got, []int{7, 8}) || head.RingLen() != 2 || len(removed) != 3 {
+		t.Errorf("Splice of whole circular chain: got %v, removed %v, want ring [7 8]", got, removed)
+	}
+
+	var empty *Node[int]
+	head, removed = empty.Splice(3, 1, 1, 2)
+	if got := valuesOf(head); !slices.Equal(got, []int{1, 2}) || len(removed) != 0 {
+		t.Errorf("Splice of nil node: got %v, removed %v, want [1 2], nothing removed", got, removed)
+	}
+}
+
+func TestRemoveRange(t *testing.T) {
+	for _, test := range []struct {
+		desc       string
+		anchor     int
+		start, end int
+		want       []int
+	}{
+		{desc: "middle", anchor: 0, start: 1, end: 3, want: []int{0, 3, 4}},
+		{desc: "from anchor", anchor: 0, start: 0, end: 2, want: []int{2, 3, 4}},
+		{desc: "to tail", anchor: 0, start: 3, end: 5, want: []int{0, 1, 2}},
+		{desc: "empty range", anchor: 0, start: 2, end: 2, want: []int{0, 1, 2, 3, 4}},
+		{desc: "relative to middle anchor", anchor: 2, start: 0, end: 2, want: []int{0, 1, 4}},
+		{desc: "everything", anchor: 0, start: 0, end: 5, want: nil},
+	} {
+		nodes := chainOf(0, 1, 2, 3, 4)
+		head, err := nodes[test.anchor].RemoveRange(test.start, test.end)
+		if err != nil {
+			t.Errorf("RemoveRange: %s: unexpected error %v", test.desc, err)
+			continue
+		}
+		if test.want == nil {
+			if head != nil {
+				t.Errorf("RemoveRange: %s: got head %v, want nil", test.desc, head)
+			}
+		} else if head == nil || head.Prev != nil || !wellLinked(head) {
+			t.Errorf("RemoveRange: %s: returned %v, want a well-formed head", test.desc, head)
+		} else if got := valuesOf(head); !slices.Equal(got, test.want) {
+			t.Errorf("RemoveRange: %s: got %v, want %v", test.desc, got, test.want)
+		}
+		for i := test.anchor + test.start; i < test.anchor+test.end; i++ {
+			if nodes[i].Next != nil || nodes[i].Prev != nil {
+				t.Errorf("RemoveRange: %s: removed node %d still has pointers", test.desc, i)
+			}
+		}
+	}
+
+	for _, r := range [][2]int{{-1, 2}, {3, 2}, {0, 6}, {4, 7}} {
+		nodes := chainOf(0, 1, 2, 3, 4)
+		if _, err := nodes[0].RemoveRange(r[0], r[1]); err == nil {
+			t.Errorf("RemoveRange(%d, %d): got nil error, want error", r[0], r[1])
+		}
+		if got, want := valuesOf(nodes[0]), []int{0, 1, 2, 3, 4}; !slices.Equal(got, want) {
+			t.Errorf("RemoveRange(%d, %d): chain changed to %v on error", r[0], r[1], got)
+		}
+	}
+}
+
+func TestPeek(t *testing.T) {
+	nodes := chainOf(0, 1, 2, 3)
+	for _, test := range []struct {
+		desc string
+		got  []int
+		want []int
+	}{
+		{desc: "PeekNext within chain", got: nodes[0].PeekNext(2), want: []int{0, 1}},
+		{desc: "PeekNext past tail", got: nodes[2].PeekNext(5), want: []int{2, 3}},
+		{desc: "PeekNext zero", got: nodes[0].PeekNext(0), want: nil},
+		{desc: "PeekPrev within chain", got: nodes[3].PeekPrev(3), want: []int{3, 2, 1}},
+		{desc: "PeekPrev past head", got: nodes[1].PeekPrev(5), want: []int{1, 0}},
+		{desc: "PeekPrev negative", got: nodes[1].PeekPrev(-1), want: nil},
+	} {
+		if !slices.Equal(test.got, test.want) {
+			t.Errorf("%s: got %v, want %v", test.desc, test.got, test.want)
+		}
+	}
+
+	closeLoop(nodes)
+	if got, want := nodes[2].PeekNext(10), []int{2, 3, 0, 1}; !slices.Equal(got, want) {
+		t.Errorf("PeekNext on circular chain: got %v, want %v", got, want)
+	}
+	if got, want := nodes[2].PeekPrev(10), []int{2, 1, 0, 3}; !slices.Equal(got, want) {
+		t.Errorf("PeekPrev on circular chain: got %v, want %v", got, want)
+	}
+}
+
+func TestRepairPrev(t *testing.T) {
+	// Forward-only chain
+	nodes := []*Node[int]{New[int](0), New[int](1), New[int](2), New[int](3)}
+	for i := 1; i < len(nodes); i++ {
+		nodes[i-1].Next = nodes[i]
+	}
+	if got := nodes[0].RepairPrev(); got != nodes[0] {
+		t.Errorf("RepairPrev of forward-only chain returned %v, want %v", got, nodes[0])
+	}
+	if !wellLinked(nodes[0]) || nodes[0].Prev != nil {
+		t.Errorf("RepairPrev of forward-only chain: Prev pointers not repaired")
+	}
+
+	// Prev pointers out of sync after splicing
+	nodes = chainOf(0, 1, 2, 3)
+	nodes[2].Prev = nodes[0]
+	nodes[3].Prev = nil
+	if got := nodes[1].RepairPrev(); got != nodes[0] || !wellLinked(nodes[0]) {
+		t.Errorf("RepairPrev of out-of-sync chain: returned %v, chain well-linked: %v", got, wellLinked(nodes[0]))
+	}
+	if got, want := nodes[3].PeekPrev(4), []int{3, 2, 1, 0}; !slices.Equal(got, want) {
+		t.Errorf("RepairPrev of out-of-sync chain: backward values %v, want %v", got, want)
+	}
+
+	// Circular chain with a missing Prev
+	nodes = chainOf(0, 1, 2, 3)
+	closeLoop(nodes)
+	nodes[0].Prev = nil
+	if got := nodes[2].RepairPrev(); got != nodes[0] || nodes[0].Prev != nodes[3] || !wellLinked(nodes[0]) {
+		t.Errorf("RepairPrev of circular chain: returned %v, head.Prev = %v", got, nodes[0].Prev)
+	}
+
+	// Chain that loops back to a node in the middle
+	nodes = chainOf(0, 1, 2, 3)
+	nodes[3].Next = nodes[1]
+	if got := nodes[0].RepairPrev(); got != nodes[0] || nodes[1].Prev != nodes[0] {
+		t.Errorf("RepairPrev of rho-shaped chain: returned %v, nodes[1].Prev = %v", got, nodes[1].Prev)
+	}
+}
+
+func TestEach(t *testing.T) {
+	nodes := chainOf(0, 1, 2, 3)
+	var got []int
+	nodes[1].Each(func(node *Node[int]) {
+		got = append(got, node.Value)
+	})
+	if want := []int{1, 2, 3}; !slices.Equal(got, want) {
+		t.Errorf("Each: got %v, want %v", got, want)
+	}
+
+	closeLoop(nodes)
+	got = nil
+	nodes[1].EachValue(func(v int) {
+		got = append(got, v)
+	})
+	if want := []int{1, 2, 3, 0}; !slices.Equal(got, want) {
+		t.Errorf("EachValue on circular chain: got %v, want %v", got, want)
+	}
+
+	var empty *Node[int]
+	empty.EachValue(func(v int) {
+		t.Errorf("EachValue on nil node: callback invoked with %d", v)
+	})
+}
+
+func TestBisect(t *testing.T) {
+	for _, test := range []struct {
+		values    []int
+		wantLeft  []int
+		wantRight []int
+	}{
+		{values: []int{0}, wantLeft: []int{0}},
+		{values: []int{0, 1}, wantLeft: []int{0}, wantRight: []int{1}},
+		{values: []int{0, 1, 2}, wantLeft: []int{0}, wantRight: []int{1, 2}},
+		{values: []int{0, 1, 2, 3}, wantLeft: []int{0, 1}, wantRight: []int{2, 3}},
+		{values: []int{0, 1, 2, 3, 4}, wantLeft: []int{0, 1}, wantRight: []int{2, 3, 4}},
+	} {
+		nodes := chainOf(test.values...)
+		left, right := nodes[0].Bisect()
+		if got := valuesOf(left); !slices.Equal(got, test.wantLeft) || left.Tail().Next != nil {
+			t.Errorf("Bisect(%v): left = %v, want %v", test.values, got, test.wantLeft)
+		}
+		if got := valuesOf(right); !slices.Equal(got, test.wantRight) || (right != nil && right.Prev != nil) {
+			t.Errorf("Bisect(%v): right = %v, want %v", test.values, got, test.wantRight)
+		}
+	}
+
+	var empty *Node[int]
+	if left, right := empty.Bisect(); left != nil || right != nil {
+		t.Errorf("Bisect of nil = %v, %v; want nil, nil", left, right)
+	}
+
+	nodes := chainOf(0, 1, 2, 3)
+	closeLoop(nodes)
+	if left, right := nodes[0].Bisect(); left != nil || right != nil || !nodes[0].Circular() {
+		t.Errorf("Bisect of circular chain = %v, %v; want nil, nil and an intact ring", left, right)
+	}
+}
+
+func TestRotateUntil(t *testing.T) {
+	for _, test := range []struct {
+		desc string
+		pred func(int) bool
+		want []int
+	}{
+		{desc: "head matches", pred: func(v int) bool { return v >= 0 }, want: []int{0, 1, 2, 3}},
+		{desc: "middle matches", pred: func(v int) bool { return v > 1 }, want: []int{2, 3, 0, 1}},
+		{desc: "tail matches", pred: func(v int) bool { return v == 3 }, want: []int{3, 0, 1, 2}},
+	} {
+		nodes := chainOf(0, 1, 2, 3)
+		head, err := nodes[2].RotateUntil(test.pred)
+		if err != nil {
+			t.Errorf("RotateUntil: %s: unexpected error %v", test.desc, err)
+			continue
+		}
+		if got := valuesOf(head); !slices.Equal(got, test.want) || head.Prev != nil || !wellLinked(head) {
+			t.Errorf("RotateUntil: %s: got %v, want %v", test.desc, got, test.want)
+		}
+	}
+
+	nodes := chainOf(0, 1, 2, 3)
+	if _, err := nodes[0].RotateUntil(func(v int) bool { return v > 5 }); !errors.Is(err, ErrNotFound) {
+		t.Errorf("RotateUntil without match: error = %v, want ErrNotFound", err)
+	}
+
+	closeLoop(nodes)
+	head, err := nodes[3].RotateUntil(func(v int) bool { return v%2 == 1 })
+	if err != nil || head != nodes[3] || !nodes[0].Circular() {
+		t.Errorf("RotateUntil on circular chain = %v, %v; want %v and an intact ring", head, err, nodes[3])
+	}
+}
+
+func TestSeek(t *testing.T) {
+	even := func(v int) bool { return v%2 == 0 }
+	nodes := chainOf(0, 1, 2, 3, 4)
+	for _, test := range []struct {
+		desc string
+		got  *Node[int]
+		want *Node[int]
+	}{
+		{desc: "SeekNext skips the node itself", got: nodes[0].SeekNext(even), want: nodes[2]},
+		{desc: "SeekNext from odd node", got: nodes[3].SeekNext(even), want: nodes[4]},
+		{desc: "SeekNext at tail", got: nodes[4].SeekNext(even), want: nil},
+		{desc: "SeekPrev skips the node itself", got: nodes[4].SeekPrev(even), want: nodes[2]},
+		{desc: "SeekPrev from odd node", got: nodes[1].SeekPrev(even), want: nodes[0]},
+		{desc: "SeekPrev at head", got: nodes[0].SeekPrev(even), want: nil},
+	} {
+		if test.got != test.want {
+			t.Errorf("%s: got %v, want %v", test.desc, test.got, test.want)
+		}
+	}
+
+	closeLoop(nodes)
+	if got := nodes[4].SeekNext(even); got != nodes[0] {
+		t.Errorf("SeekNext on circular chain: got %v, want %v", got, nodes[0])
+	}
+	if got := nodes[2].SeekNext(func(v int) bool { return v == 2 }); got != nil {
+		t.Errorf("SeekNext on circular chain, only the node itself matches: got %v, want nil", got)
+	}
+	if got := nodes[0].SeekPrev(even); got != nodes[4] {
+		t.Errorf("SeekPrev on circular chain: got %v, want %v", got, nodes[4])
+	}
+}
+
+func TestCut(t *testing.T) {
+	nodes := chainOf(0, 1, 2, 3)
+	before, after := nodes[2].Cut()
+	if got := valuesOf(before); before != nodes[0] || !slices.Equal(got, []int{0, 1}) || nodes[1].Next != nil || !wellLinked(before) {
+		t.Errorf("Cut: before = %v, want [0 1]", got)
+	}
+	if got := valuesOf(after); after != nodes[2] || !slices.Equal(got, []int{2, 3}) || after.Prev != nil || !wellLinked(after) {
+		t.Errorf("Cut: after = %v, want [2 3]", got)
+	}
+
+	before, after = nodes[0].Cut()
+	if before != nil || after != nodes[0] || !slices.Equal(valuesOf(after), []int{0, 1}) {
+		t.Errorf("Cut at head: got %v, %v; want nil and the unchanged chain", before, after)
+	}
+
+	nodes = chainOf(0, 1, 2)
+	closeLoop(nodes)
+	before, after = nodes[1].Cut()
+	if got := valuesOf(after); before != nil || after != nodes[1] || !slices.Equal(got, []int{1, 2, 0}) || after.Prev != nil || nodes[0].Next != nil {
+		t.Errorf("Cut of circular chain: got before %v, after %v; want nil and linear [1 2 0]", before, got)
+	}
+
+	var empty *Node[int]
+	if before, after := empty.Cut(); before != nil || after != nil {
+		t.Errorf("Cut of nil node = %v, %v; want nil, nil", before, after)
+	}
+}
+
+func TestSplitN(t *testing.T) {
+	for _, test := range []struct {
+		length int
+		parts  int
+		want   [][]int
+	}{
+		{length: 5, parts: 2, want: [][]int{{0, 1, 2}, {3, 4}}},
+		{length: 6, parts: 3, want: [][]int{{0, 1}, {2, 3}, {4, 5}}},
+		{length: 7, parts: 3, want: [][]int{{0, 1, 2}, {3, 4}, {5, 6}}},
+		{length: 3, parts: 5, want: [][]int{{0}, {1}, {2}}},
+		{length: 3, parts: 0, want: [][]int{{0, 1, 2}}},
+		{length: 1, parts: 1, want: [][]int{{0}}},
+	} {
+		var values []int
+		for i := range test.length {
+			values = append(values, i)
+		}
+		heads := chainOf(values...)[0].SplitN(test.parts)
+		var got [][]int
+		for _, head := range heads {
+			if head.Prev != nil || head.Tail().Next != nil {
+				t.Errorf("SplitN(%d) of length %d: part %v is not severed", test.parts, test.length, valuesOf(head))
+			}
+			got = append(got, valuesOf(head))
+		}
+		if !slices.EqualFunc(got, test.want, slices.Equal) {
+			t.Errorf("SplitN(%d) of length %d: got %v, want %v", test.parts, test.length, got, test.want)
+		}
+	}
+
+	var empty *Node[int]
+	if got := empty.SplitN(2); got != nil {
+		t.Errorf("SplitN of nil node = %v, want nil", got)
+	}
+
+	nodes := chainOf(0, 1, 2, 3)
+	closeLoop(nodes)
+	heads := nodes[1].SplitN(2)
+	if len(heads) != 2 || !slices.Equal(valuesOf(heads[0]), []int{1, 2}) || !slices.Equal(valuesOf(heads[1]), []int{3, 0}) {
+		t.Errorf("SplitN of circular chain: got heads %v", heads)
+	}
+	if heads[0].Prev != nil || heads[1].Tail().Next != nil {
+		t.Errorf("SplitN of circular chain: ring was not opened")
+	}
+}
+
+func TestTrimFunc(t *testing.T) {
+	isZero := func(v int) bool { return v == 0 }
+	for _, test := range []struct {
+		values []int
+		anchor int
+		want   []int
+	}{
+		{values: []int{0, 0, 1, 0, 2, 0}, anchor: 2, want: []int{1, 0, 2}},
+		{values: []int{1, 2}, anchor: 0, want: []int{1, 2}},
+		{values: []int{0, 1}, anchor: 0, want: []int{1}},
+		{values: []int{1, 0}, anchor: 1, want: []int{1}},
+		{values: []int{0, 0, 0}, anchor: 1, want: nil},
+		{values: []int{0}, anchor: 0, want: nil},
+	} {
+		nodes := chainOf(test.values...)
+		head := nodes[test.anchor].TrimFunc(isZero)
+		if got := valuesOf(head); !slices.Equal(got, test.want) {
+			t.Errorf("TrimFunc(%v) = %v, want %v", test.values, got, test.want)
+		}
+		if head != nil && (head.Prev != nil || head.Tail().Next != nil || !wellLinked(head)) {
+			t.Errorf("TrimFunc(%v): result is malformed", test.values)
+		}
+		kept := map[*Node[int]]bool{}
+		head.Each(func(node *Node[int]) { kept[node] = true })
+		for _, node := range nodes {
+			if kept[node] {
+				continue
+			}
+			if node.Next != nil || node.Prev != nil {
+				t.Errorf("TrimFunc(%v): removed node %v still has pointers", test.values, node)
+			}
+		}
+	}
+
+	nodes := chainOf(0, 1, 0)
+	closeLoop(nodes)
+	if got := nodes[1].TrimFunc(isZero); got != nodes[1] || !nodes[0].Circular() {
+		t.Errorf("TrimFunc of circular chain = %v, want %v and an intact ring", got, nodes[1])
+	}
+}
+
+func TestReplaceWhere(t *testing.T) {
+	negative := func(v int) bool { return v < 0 }
+
+	nodes := chainOf(1, -2, 3, -4)
+	nodes[0].ReplaceWhere(negative, 0)
+	if got, want := valuesOf(nodes[0]), []int{1, 0, 3, 0}; !slices.Equal(got, want) {
+		t.Errorf("ReplaceWhere: got %v, want %v", got, want)
+	}
+
+	nodes = chainOf(1, -2, 3, -4)
+	closeLoop(nodes)
+	nodes[2].ReplaceWhereFunc(negative, func(v int) int { return -v })
+	if got, want := valuesOf(nodes[0]), []int{1, 2, 3, 4}; !slices.Equal(got, want) {
+		t.Errorf("ReplaceWhereFunc on circular chain: got %v, want %v", got, want)
+	}
+}
+
+func TestRemoveFirst(t *testing.T) {
+	even := func(v int) bool { return v%2 == 0 }
+	for _, test := range []struct {
+		desc    string
+		values  []int
+		anchor  int
+		removed int
+		want    []int
+	}{
+		{desc: "middle", values: []int{1, 2, 3, 4}, anchor: 0, removed: 1, want: []int{1, 3, 4}},
+		{desc: "head", values: []int{2, 3, 4}, anchor: 0, removed: 0, want: []int{3, 4}},
+		{desc: "tail", values: []int{1, 3, 4}, anchor: 1, removed: 2, want: []int{1, 3}},
+		{desc: "only node", values: []int{2}, anchor: 0, removed: 0, want: nil},
+		{desc: "no match", values: []int{1, 3}, anchor: 0, removed: -1, want: []int{1, 3}},
+		{desc: "before anchor is not searched", values: []int{2, 3}, anchor: 1, removed: -1, want: []int{2, 3}},
+	} {
+		nodes := chainOf(test.values...)
+		head := nodes[test.anchor].RemoveFirst(even)
+		if got := valuesOf(head); !slices.Equal(got, test.want) {
+			t.Errorf("RemoveFirst: %s: got %v, want %v", test.desc, got, test.want)
+		}
+		if head != nil && (head.Prev != nil || !wellLinked(head)) {
+			t.Errorf("RemoveFirst: %s: returned node is not a well-formed head", test.desc)
+		}
+		if test.removed >= 0 && (nodes[test.removed].Next != nil || nodes[test.removed].Prev != nil) {
+			t.Errorf("RemoveFirst: %s: removed node still has pointers", test.desc)
+		}
+	}
+
+	nodes := chainOf(2, 3, 5)
+	closeLoop(nodes)
+	if got := nodes[0].RemoveFirst(even); got != nodes[1] || !slices.Equal(valuesOf(got), []int{3, 5}) || !got.Circular() {
+		t.Errorf("RemoveFirst of circular chain = %v, want ring starting at %v", got, nodes[1])
+	}
+}
+
+func TestFindMoveToFront(t *testing.T) {
+	for _, test := range []struct {
+		target int
+		want   []int
+	}{
+		{target: 3, want: []int{3, 1, 2, 4}},
+		{target: 4, want: []int{4, 1, 2, 3}},
+		{target: 1, want: []int{1, 2, 3, 4}},
+		{target: 9, want: []int{1, 2, 3, 4}},
+	} {
+		nodes := chainOf(1, 2, 3, 4)
+		head := nodes[1].FindMoveToFront(func(v int) bool { return v == test.target })
+		if got := valuesOf(head); !slices.Equal(got, test.want) || head.Prev != nil || !wellLinked(head) {
+			t.Errorf("FindMoveToFront(%d): got %v, want %v", test.target, got, test.want)
+		}
+	}
+
+	nodes := chainOf(1, 2, 3)
+	closeLoop(nodes)
+	head := nodes[0].FindMoveToFront(func(v int) bool { return v == 2 })
+	if got := valuesOf(head); head != nodes[1] || !slices.Equal(got, []int{2, 1, 3}) || nodes[0].RingLen() != 3 {
+		t.Errorf("FindMoveToFront in circular chain: got %v, want [2 1 3]", got)
+	}
+
+	var empty *Node[int]
+	if got := empty.FindMoveToFront(func(int) bool { return true }); got != nil {
+		t.Errorf("FindMoveToFront on nil node = %v, want nil", got)
+	}
+}
+
+func TestPushFrontBack(t *testing.T) {
+	nodes := chainOf(1, 2)
+	head := nodes[1].PushFront(0)
+	tail := nodes[0].PushBack(3)
+	if got, want := valuesOf(head), []int{0, 1, 2, 3}; !slices.Equal(got, want) || !wellLinked(head) {
+		t.Errorf("PushFront/PushBack: got %v, want %v", got, want)
+	}
+	if head.Prev != nil || head != nodes[0].Head() || tail.Next != nil || tail != nodes[0].Tail() {
+		t.Errorf("PushFront/PushBack: returned %v and %v, want the new head and tail", head, tail)
+	}
+
+	var empty *Node[int]
+	if got := empty.PushFront(1); got == nil || got.Value != 1 || got.Next != nil || got.Prev != nil {
+		t.Errorf("PushFront on nil node = %v, want a new single node", got)
+	}
+	if got := empty.PushBack(1); got == nil || got.Value != 1 || got.Next != nil || got.Prev != nil {
+		t.Errorf("PushBack on nil node = %v, want a new single node", got)
+	}
+
+	nodes = chainOf(1, 2)
+	closeLoop(nodes)
+	nodes[1].PushFront(0)
+	nodes[1].PushBack(3)
+	if got, want := valuesOf(nodes[0]), []int{1, 0, 2, 3}; !slices.Equal(got, want) || !nodes[0].Circular() {
+		t.Errorf("PushFront/PushBack on circular chain: got %v, want ring %v", got, want)
+	}
+}
+
+func TestSwapAdjacent(t *testing.T) {
+	for _, test := range []struct {
+		desc string
+		swap func(nodes []*Node[int])
+		want []int
+	}{
+		{desc: "SwapWithNext in the middle", swap: func(nodes []*Node[int]) { nodes[1].SwapWithNext() }, want: []int{0, 2, 1, 3}},
+		{desc: "SwapWithNext at head", swap: func(nodes []*Node[int]) { nodes[0].SwapWithNext() }, want: []int{1, 0, 2, 3}},
+		{desc: "SwapWithNext before tail", swap: func(nodes []*Node[int]) { nodes[2].SwapWithNext() }, want: []int{0, 1, 3, 2}},
+		{desc: "SwapWithNext at tail", swap: func(nodes []*Node[int]) { nodes[3].SwapWithNext() }, want: []int{0, 1, 2, 3}},
+		{desc: "SwapWithPrev in the middle", swap: func(nodes []*Node[int]) { nodes[2].SwapWithPrev() }, want: []int{0, 2, 1, 3}},
+		{desc: "SwapWithPrev at head", swap: func(nodes []*Node[int]) { nodes[0].SwapWithPrev() }, want: []int{0, 1, 2, 3}},
+	} {
+		nodes := chainOf(0, 1, 2, 3)
+		test.swap(nodes)
+		head := nodes[0].Head()
+		if got := valuesOf(head); !slices.Equal(got, test.want) || !wellLinked(head) || head.Tail().Next != nil {
+			t.Errorf("%s: got %v, want %v", test.desc, got, test.want)
+		}
+	}
+
+	for size := 1; size <= 4; size++ {
+		var values []int
+		for i := range size {
+			values = append(values, i)
+		}
+		nodes := chainOf(values...)
+		closeLoop(nodes)
+		nodes[0].SwapWithNext()
+		if got := nodes[0].RingLen(); got != size || !wellLinked(nodes[0]) {
+			t.Errorf("SwapWithNext in ring of %d: ring length %d, well-linked %v", size, got, wellLinked(nodes[0]))
+		}
+		if size > 2 && nodes[1].Next != nodes[0] {
+			t.Errorf("SwapWithNext in ring of %d: nodes were not swapped", size)
+		}
+	}
+}
+
+func TestIsEmptyIsSingleton(t *testing.T) {
+	var empty *Node[int]
+	single := New[int](0)
+	ring := New[int](0)
+	ring.Next, ring.Prev = ring, ring
+	pair := chainOf(0, 1)
+	for _, test := range []struct {
+		desc              string
+		node              *Node[int]
+		isEmpty, isSingle bool
+	}{
+		{desc: "nil", node: empty, isEmpty: true},
+		{desc: "single node", node: single, isSingle: true},
+		{desc: "one-node ring", node: ring},
+		{desc: "head of pair", node: pair[0]},
+		{desc: "tail of pair", node: pair[1]},
+	} {
+		if got := IsEmpty(test.node); got != test.isEmpty {
+			t.Errorf("IsEmpty: %s: got %v, want %v", test.desc, got, test.isEmpty)
+		}
+		if got := IsSingleton(test.node); got != test.isSingle {
+			t.Errorf("IsSingleton: %s: got %v, want %v", test.desc, got, test.isSingle)
+		}
+	}
+}
+
+func TestDetached(t *testing.T) {
+	nodes := chainOf(0, 1, 2)
+	if nodes[1].Detached() {
+		t.Errorf("Detached of linked node = true, want false")
+	}
+	nodes[1].DeleteN(1)
+	if !nodes[1].Detached() {
+		t.Errorf("Detached of removed node = false, want true")
+	}
+	if nodes[0].Detached() || nodes[2].Detached() {
+		t.Errorf("Detached of remaining nodes = true, want false")
+	}
+	var empty *Node[int]
+	if empty.Detached() {
+		t.Errorf("Detached of nil node = true, want false")
+	}
+}
+
+func TestEnds(t *testing.T) {
+	nodes := chainOf(0, 1, 2, 3)
+	for _, node := range nodes {
+		head, tail := node.Ends()
+		if head != node.Head() || tail != node.Tail() {
+			t.Errorf("Ends() from %d = %v, %v; want %v, %v", node.Value, head, tail, node.Head(), node.Tail())
+		}
+	}
+
+	single := New[int](0)
+	if head, tail := single.Ends(); head != single || tail != single {
+		t.Errorf("Ends() of single node = %v, %v; want the node twice", head, tail)
+	}
+	var empty *Node[int]
+	if head, tail := empty.Ends(); head != nil || tail != nil {
+		t.Errorf("Ends() of nil node = %v, %v; want nil, nil", head, tail)
+	}
+
+	closeLoop(nodes)
+	if head, tail := nodes[2].Ends(); head != nil || tail != nil {
+		t.Errorf("Ends() of circular chain = %v, %v; want nil, nil", head, tail)
+	}
+}
+
+func TestSwapEnds(t *testing.T) {
+	for _, test := range []struct {
+		values []int
+		want   []int
+	}{
+		{values: []int{0}, want: []int{0}},
+		{values: []int{0, 1}, want: []int{1, 0}},
+		{values: []int{0, 1, 2}, want: []int{2, 1, 0}},
+		{values: []int{0, 1, 2, 3}, want: []int{3, 1, 2, 0}},
+	} {
+		nodes := chainOf(test.values...)
+		nodes[len(nodes)/2].SwapEnds()
+		head := nodes[len(nodes)-1]
+		if got := valuesOf(head); !slices.Equal(got, test.want) || head.Prev != nil || !wellLinked(head) {
+			t.Errorf("SwapEnds(%v): got %v, want %v", test.values, got, test.want)
+		}
+		if tail := nodes[0]; tail.Next != nil {
+			t.Errorf("SwapEnds(%v): old head isn't the tail", test.values)
+		}
+	}
+
+	// Two nodes: both are each other's neighbors, before and after.
+	pair := chainOf(0, 1)
+	pair[0].SwapEnds()
+	if pair[1].Prev != nil || pair[1].Next != pair[0] || pair[0].Prev != pair[1] || pair[0].Next != nil {
+		t.Errorf("SwapEnds of two nodes: pointers not swapped correctly")
+	}
+
+	ring := chainOf(0, 1, 2)
+	closeLoop(ring)
+	ring[0].SwapEnds()
+	if got := valuesOf(ring[0]); !slices.Equal(got, []int{0, 1, 2}) || ring[0].RingLen() != 3 {
+		t.Errorf("SwapEnds of circular chain: got %v, want no change", got)
+	}
+
+	var empty *Node[int]
+	empty.SwapEnds()
+}
+
+func TestShiftValues(t *testing.T) {
+	for _, test := range []struct {
+		k    int
+		want []int
+	}{
+		{k: 0, want: []int{0, 1, 2, 3}},
+		{k: 1, want: []int{3, 0, 1, 2}},
+		{k: -1, want: []int{1, 2, 3, 0}},
+		{k: 6, want: []int{2, 3, 0, 1}},
+		{k: -7, want: []int{3, 0, 1, 2}},
+	} {
+		nodes := chainOf(0, 1, 2, 3)
+		nodes[2].ShiftValues(test.k)
+		if got := valuesOf(nodes[0]); !slices.Equal(got, test.want) {
+			t.Errorf("ShiftValues(%d) = %v, want %v", test.k, got, test.want)
+		}
+		if nodes[0].Next != nodes[1] || nodes[3].Prev != nodes[2] {
+			t.Errorf("ShiftValues(%d) changed the links", test.k)
+		}
+	}
+
+	nodes := chainOf(0, 1, 2, 3)
+	closeLoop(nodes)
+	nodes[2].ShiftValues(1)
+	if got, want := valuesOf(nodes[0]), []int{3, 0, 1, 2}; !slices.Equal(got, want) {
+		t.Errorf("ShiftValues(1) on circular chain = %v, want %v", got, want)
+	}
+
+	var empty *Node[int]
+	empty.ShiftValues(1)
+}
+
+func TestStabilize(t *testing.T) {
+	halve := func(v *int) bool {
+		if *v > 5 {
+			*v /= 2
+			return true
+		}
+		return false
+	}
+	nodes := chainOf(20, 4, 7)
+	if got := nodes[0].Stabilize(halve); got != 3 {
+		t.Errorf("Stabilize: got %d passes, want 3", got)
+	}
+	if got := valuesOf(nodes[0]); !slices.Equal(got, []int{5, 4, 3}) {
+		t.Errorf("Stabilize: got %v, want [5 4 3]", got)
+	}
+	if got := nodes[0].Stabilize(halve); got != 1 {
+		t.Errorf("Stabilize of stable chain: got %d passes, want 1", got)
+	}
+
+	closeLoop(nodes)
+	calls := 0
+	nodes[0].Stabilize(func(*int) bool { calls++; return false })
+	if calls != 3 {
+		t.Errorf("Stabilize of circular chain: got %d calls, want 3", calls)
+	}
+
+	if got := nodes[0].Stabilize(func(v *int) bool { *v++; return true }); got != MaxStabilizePasses {
+		t.Errorf("Stabilize of never-stable chain: got %d passes, want %d", got, MaxStabilizePasses)
+	}
+
+	var empty *Node[int]
+	if got := empty.Stabilize(halve); got != 0 {
+		t.Errorf("Stabilize of nil node: got %d passes, want 0", got)
+	}
+}
+
+func TestInsertBetween(t *testing.T) {
+	nodes := chainOf(0, 2, 3)
+	if err := InsertBetween(nodes[0], nodes[1], New[int](1)); err != nil {
+		t.Errorf("InsertBetween(0, 2): unexpected error %v", err)
+	}
+	if got, want := valuesOf(nodes[0]), []int{0, 1, 2, 3}; !slices.Equal(got, want) || !wellLinked(nodes[0]) {
+		t.Errorf("InsertBetween(0, 2): got %v, want %v", got, want)
+	}
+
+	for _, test := range []struct {
+		desc string
+		a, b *Node[int]
+		node *Node[int]
+	}{
+		{desc: "not adjacent", a: nodes[0], b: nodes[2], node: New[int](9)},
+		{desc: "reversed", a: nodes[2], b: nodes[1], node: New[int](9)},
+		{desc: "nil neighbor", a: nodes[2], b: nil, node: New[int](9)},
+		{desc: "nil node", a: nodes[1], b: nodes[2], node: nil},
+		{desc: "linked node", a: nodes[1], b: nodes[2], node: chainOf(8, 9)[0]},
+	} {
+		if err := InsertBetween(test.a, test.b, test.node); err == nil {
+			t.Errorf("InsertBetween: %s: got nil error, want error", test.desc)
+		}
+	}
+	if got, want := valuesOf(nodes[0]), []int{0, 1, 2, 3}; !slices.Equal(got, want) {
+		t.Errorf("InsertBetween: refused inserts changed the chain to %v, want %v", got, want)
+	}
+}
+
+func TestLenUpTo(t *testing.T) {
+	nodes := chainOf(0, 1, 2, 3)
+	for _, test := range []struct {
+		start, limit int
+		want         int
+	}{
+		{start: 0, limit: 10, want: 4},
+		{start: 0, limit: 2, want: 2},
+		{start: 2, limit: 10, want: 2},
+		{start: 0, limit: 0, want: 0},
+	} {
+		if got := nodes[test.start].LenUpTo(test.limit); got != test.want {
+			t.Errorf("LenUpTo(%d) from %d = %d, want %d", test.limit, test.start, got, test.want)
+		}
+	}
+	closeLoop(nodes)
+	if got := nodes[2].LenUpTo(10); got != 4 {
+		t.Errorf("LenUpTo(10) of circular chain = %d, want 4", got)
+	}
+}
+
+func TestTruncate(t *testing.T) {
+	for _, test := range []struct {
+		maxLen   int
+		want     []int
+		wantRest []int
+	}{
+		{maxLen: 3, want: []int{0, 1, 2}, wantRest: []int{3, 4}},
+		{maxLen: 5, want: []int{0, 1, 2, 3, 4}},
+		{maxLen: 9, want: []int{0, 1, 2, 3, 4}},
+		{maxLen: 0, want: []int{0}, wantRest: []int{1, 2, 3, 4}},
+	} {
+		nodes := chainOf(0, 1, 2, 3, 4)
+		if got := nodes[0].Truncate(test.maxLen); got != nodes[0] {
+			t.Errorf("Truncate(%d) = %v, want the node itself", test.maxLen, got)
+		}
+		if got := valuesOf(nodes[0]); !slices.Equal(got, test.want) || !wellLinked(nodes[0]) {
+			t.Errorf("Truncate(%d): got %v, want %v", test.maxLen, got, test.want)
+		}
+		if len(test.wantRest) > 0 {
+			rest := nodes[len(test.want)]
+			if got := valuesOf(rest); rest.Prev != nil || !slices.Equal(got, test.wantRest) {
+				t.Errorf("Truncate(%d): cut-off part %v, want detached %v", test.maxLen, got, test.wantRest)
+			}
+		}
+	}
+
+	// Nodes to the left are kept
+	nodes := chainOf(0, 1, 2, 3)
+	nodes[1].Truncate(2)
+	if got := valuesOf(nodes[0]); !slices.Equal(got, []int{0, 1, 2}) {
+		t.Errorf("Truncate from middle: got %v, want [0 1 2]", got)
+	}
+
+	nodes = chainOf(0, 1, 2, 3)
+	closeLoop(nodes)
+	nodes[1].Truncate(2)
+	if got := valuesOf(nodes[1]); !slices.Equal(got, []int{1, 2}) || nodes[1].Prev != nil || !wellLinked(nodes[1]) {
+		t.Errorf("Truncate of circular chain: got %v, want linear [1 2]", got)
+	}
+	if got := valuesOf(nodes[3]); !slices.Equal(got, []int{3, 0}) || nodes[0].Next != nil {
+		t.Errorf("Truncate of circular chain: cut-off part %v, want linear [3 0]", got)
+	}
+
+	nodes = chainOf(0, 1)
+	closeLoop(nodes)
+	nodes[0].Truncate(2)
+	if nodes[0].RingLen() != 2 {
+		t.Errorf("Truncate of short circular chain: ring was opened")
+	}
+}
+
+func TestRotateRange(t *testing.T) {
+	for _, test := range []struct {
+		count, k  int
+		want      []int
+		wantFirst int
+	}{
+		{count: 4, k: 1, want: []int{9, 1, 2, 3, 0, 9}, wantFirst: 1},
+		{count: 4, k: -1, want: []int{9, 3, 0, 1, 2, 9}, wantFirst: 3},
+		{count: 4, k: 6, want: []int{9, 2, 3, 0, 1, 9}, wantFirst: 2},
+		{count: 2, k: 1, want: []int{9, 1, 0, 2, 3, 9}, wantFirst: 1},
+		{count: 9, k: 2, want: []int{9, 2, 3, 9, 0, 1}, wantFirst: 2},
+		{count: 4, k: 4, want: []int{9, 0, 1, 2, 3, 9}, wantFirst: 0},
+		{count: 1, k: 1, want: []int{9, 0, 1, 2, 3, 9}, wantFirst: 0},
+		{count: 0, k: 1, want: []int{9, 0, 1, 2, 3, 9}, wantFirst: 0},
+	} {
+		nodes := chainOf(9, 0, 1, 2, 3, 9)
+		first := nodes[1].RotateRange(test.count, test.k)
+		head := nodes[0].Head()
+		if got := valuesOf(head); !slices.Equal(got, test.want) || !wellLinked(head) {
+			t.Errorf("RotateRange(%d, %d): got %v, want %v", test.count, test.k, got, test.want)
+		}
+		if first.Value != test.wantFirst {
+			t.Errorf("RotateRange(%d, %d) returned %d, want %d", test.count, test.k, first.Value, test.wantFirst)
+		}
+	}
+
+	// A segment at the head: the segment's first node becomes the head
+	nodes := chainOf(0, 1, 2)
+	first := nodes[0].RotateRange(2, 1)
+	if got := valuesOf(first); first.Prev != nil || !slices.Equal(got, []int{1, 0, 2}) || !wellLinked(first) {
+		t.Errorf("RotateRange at head: got %v, want head of [1 0 2]", got)
+	}
+
+	// Part of a circular chain
+	nodes = chainOf(0, 1, 2, 3)
+	closeLoop(nodes)
+	first = nodes[0].RotateRange(3, 1)
+	if got := valuesOf(first); !slices.Equal(got, []int{1, 2, 0, 3}) || first.RingLen() != 4 {
+		t.Errorf("RotateRange in circular chain: got %v, want ring [1 2 0 3]", got)
+	}
+
+	// A whole circular chain
+	nodes = chainOf(0, 1, 2)
+	closeLoop(nodes)
+	if first = nodes[0].RotateRange(5, 1); first != nodes[1] || !slices.Equal(valuesOf(nodes[0]), []int{0, 1, 2}) {
+		t.Errorf("RotateRange of whole circular chain: got start %d, want 1 without relinking", first.Value)
+	}
+}
+
+func TestAppendBounded(t *testing.T) {
+	nodes := chainOf(0, 1, 2)
+	if err := nodes[1].AppendBounded(New[int](9), 4); err != nil {
+		t.Errorf("AppendBounded to length 4 with max 4: unexpected error %v", err)
+	}
+	if got, want := valuesOf(nodes[0]), []int{0, 1, 9, 2}; !slices.Equal(got, want) {
+		t.Errorf("AppendBounded: got %v, want %v", got, want)
+	}
+	// The nodes to the left count as well.
+	if err := nodes[2].AppendBounded(New[int](10), 4); err == nil {
+		t.Errorf("AppendBounded to length 5 with max 4: got nil error, want error")
+	}
+	if got, want := valuesOf(nodes[0]), []int{0, 1, 9, 2}; !slices.Equal(got, want) {
+		t.Errorf("AppendBounded: refused append changed the chain to %v, want %v", got, want)
+	}
+
+	single := New[int](0)
+	if err := single.AppendBounded(New[int](1), 1); err == nil {
+		t.Errorf("AppendBounded to length 2 with max 1: got nil error, want error")
+	}
+
+	nodes = chainOf(0, 1, 2)
+	closeLoop(nodes)
+	if err := nodes[1].AppendBounded(New[int](9), 3); err == nil {
+		t.Errorf("AppendBounded to ring of 4 with max 3: got nil error, want error")
+	}
+	if err := nodes[1].AppendBounded(New[int](9), 4); err != nil || nodes[0].RingLen() != 4 {
+		t.Errorf("AppendBounded to ring of 4 with max 4: error %v, ring length %d", err, nodes[0].RingLen())
+	}
+}
+
+func TestTap(t *testing.T) {
+	nodes := chainOf(1, 2, 3)
+	var seen []int
+	sum := Sum(nodes[0].Tap(func(v int) { seen = append(seen, v) }))
+	if sum != 6 || !slices.Equal(seen, []int{1, 2, 3}) {
+		t.Errorf("Tap: sum %d, seen %v; want 6, [1 2 3]", sum, seen)
+	}
+	if got := nodes[1].Tap(func(int) {}); got != nodes[1] {
+		t.Errorf("Tap returned %v, want %v", got, nodes[1])
+	}
+}
+
+func TestStride(t *testing.T) {
+	nodes := chainOf(0, 1, 2, 3, 4)
+	for _, test := range []struct {
+		step int
+		want []int
+	}{
+		{step: 1, want: []int{0, 1, 2, 3, 4}},
+		{step: 2, want: []int{0, 2, 4}},
+		{step: 3, want: []int{0, 3}},
+		{step: 10, want: []int{0}},
+		{step: 0, want: []int{0, 1, 2, 3, 4}},
+	} {
+		got := nodes[0].Stride(test.step)
+		if vals := valuesOf(got); !slices.Equal(vals, test.want) || !wellLinked(got) || got == nodes[0] {
+			t.Errorf("Stride(%d) = %v, want a new chain %v", test.step, vals, test.want)
+		}
+	}
+	var empty *Node[int]
+	if got := empty.Stride(2); got != nil {
+		t.Errorf("Stride of nil node = %v, want nil", got)
+	}
+}
@@ -0,0 +1,117 @@
+package lnode
+
+// Number is the constraint for the numeric helpers: any integer or floating-point type.
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
+
+/*
+RollingFunc slides a window of window consecutive values over the chain, starting at n and going "to the right", and returns a new chain holding the result of fn for every window position. Only full windows are considered: for N values, the result has N-window+1 nodes, and it's nil when there are fewer than window values or when window is less than 1. Circular chains are handled like VisitByNext() does: every node is visited once.
+
+The slice passed to fn is reused between calls: fn must copy it if it needs it after returning.
+
+Example:
+
+	// anchor: 1 --- 2 --- 3 --- 4
+	maxima := lnode.RollingFunc(anchor, 2, func(w []int) int { return max(w[0], w[1]) })
+	// Structure of the new chain:
+	// 2 --- 3 --- 4
+	// ^maxima
+*/
+func RollingFunc[V any, R any](n *Node[V], window int, fn func(window []V) R) *Node[R] {
+	if window < 1 {
+		return nil
+	}
+	var out builder[R]
+	buf := make([]V, 0, window)
+	n.EachValue(func(v V) {
+		if len(buf) == window {
+			copy(buf, buf[1:])
+			buf = buf[:window-1]
+		}
+		buf = append(buf, v)
+		if len(buf) == window {
+			out.add(fn(buf))
+		}
+	})
+	return out.head
+}
+
+/*
+RollingSum returns a new chain holding the sums of every window consecutive values of the chain, starting at n and going "to the right". Like RollingFunc(), only full windows are considered, so that the result is nil when there are fewer than window values or when window is less than 1. Every window is summed separately, which takes O(N*window) time but doesn't accumulate rounding errors for floating-point values.
+
+Example:
+
+	// anchor: 1 --- 2 --- 3 --- 4 --- 5
+	sums := lnode.RollingSum(anchor, 3)
+	// Structure of the new chain:
+	// 6 --- 9 --- 12
+	// ^sums
+*/
+func RollingSum[V Number](n *Node[V], window int) *Node[V] {
+	return RollingFunc(n, window, func(w []V) V {
+		var sum V
+		for _, v := range w {
+			sum += v
+		}
+		return sum
+	})
+}
+
+/*
+MovingAverage returns a new chain holding the simple moving average of every window consecutive values of the chain, starting at n and going "to the right". Like RollingSum(), only full windows are considered, so that the result is nil when there are fewer than window values or when window is less than 1. Like Average(), the values are converted to float64 before adding.
+
+Example:
+
+	// anchor: 1 --- 2 --- 3 --- 4
+	averages := lnode.MovingAverage(anchor, 2)
+	// Structure of the new chain:
+	// 1.5 --- 2.5 --- 3.5
+	// ^averages
+*/
+func MovingAverage[V Number](n *Node[V], window int) *Node[float64] {
+	return RollingFunc(n, window, func(w []V) float64 {
+		var sum float64
+		for _, v := range w {
+			sum += float64(v)
+		}
+		return sum / float64(len(w))
+	})
+}
+
+/*
+Sum returns the total of the values of the chain, starting at n and going "to the right". For a nil node, the result is the zero value. Circular chains are handled like VisitByNext() does: every node is counted once. Example:
+
+	// anchor: 1 --- 2 --- 3
+	fmt.Println(lnode.Sum(anchor))
+	// Output: 6
+*/
+func Sum[V Number](n *Node[V]) V {
+	var sum V
+	n.EachValue(func(v V) {
+		sum += v
+	})
+	return sum
+}
+
+/*
+Average returns the arithmetic mean of the values of the chain, starting at n and going "to the right", as a float64. Every value is converted to float64 before adding, so integer chains don't overflow or truncate. For a nil node, 0 is returned. Example:
+
+	// anchor: 1 --- 2
+	fmt.Println(lnode.Average(anchor))
+	// Output: 1.5
+*/
+func Average[V Number](n *Node[V]) float64 {
+	var sum float64
+	count := 0
+	n.EachValue(func(v V) {
+		sum += float64(v)
+		count++
+	})
+	if count == 0 {
+		return 0
+	}
+	return sum / float64(count)
+}
@@ -0,0 +1,99 @@
+package lnode
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestRollingSum(t *testing.T) {
+	nodes := chainOf(1, 2, 3, 4, 5)
+	for _, test := range []struct {
+		window int
+		want   []int
+	}{
+		{window: 1, want: []int{1, 2, 3, 4, 5}},
+		{window: 3, want: []int{6, 9, 12}},
+		{window: 5, want: []int{15}},
+		{window: 6, want: nil},
+		{window: 0, want: nil},
+	} {
+		got := RollingSum(nodes[0], test.window)
+		if vals := valuesOf(got); !slices.Equal(vals, test.want) || !wellLinked(got) {
+			t.Errorf("RollingSum(%d) = %v, want %v", test.window, vals, test.want)
+		}
+	}
+
+	floats := RollingSum(chainOf(0.5, 1.5, 2.0)[0], 2)
+	if got, want := valuesOf(floats), []float64{2.0, 3.5}; !slices.Equal(got, want) {
+		t.Errorf("RollingSum of floats = %v, want %v", got, want)
+	}
+}
+
+func TestRollingFunc(t *testing.T) {
+	nodes := chainOf(3, 1, 4, 1, 5)
+	var windows [][]int
+	maxima := RollingFunc(nodes[0], 2, func(w []int) int {
+		windows = append(windows, slices.Clone(w))
+		return slices.Max(w)
+	})
+	if got, want := valuesOf(maxima), []int{3, 4, 4, 5}; !slices.Equal(got, want) {
+		t.Errorf("RollingFunc maxima = %v, want %v", got, want)
+	}
+	if want := [][]int{{3, 1}, {1, 4}, {4, 1}, {1, 5}}; !slices.EqualFunc(windows, want, slices.Equal) {
+		t.Errorf("RollingFunc windows = %v, want %v", windows, want)
+	}
+}
+
+func TestMovingAverage(t *testing.T) {
+	nodes := chainOf(1, 2, 3, 4)
+	for _, test := range []struct {
+		window int
+		want   []float64
+	}{
+		{window: 1, want: []float64{1, 2, 3, 4}},
+		{window: 2, want: []float64{1.5, 2.5, 3.5}},
+		{window: 4, want: []float64{2.5}},
+		{window: 5, want: nil},
+		{window: 0, want: nil},
+		{window: -1, want: nil},
+	} {
+		got := MovingAverage(nodes[0], test.window)
+		if vals := valuesOf(got); !slices.Equal(vals, test.want) || !wellLinked(got) {
+			t.Errorf("MovingAverage(%d) = %v, want %v", test.window, vals, test.want)
+		}
+	}
+
+	// No overflow for small integer types.
+	bytes := chainOf[uint8](200, 250)
+	if got, want := valuesOf(MovingAverage(bytes[0], 2)), []float64{225}; !slices.Equal(got, want) {
+		t.Errorf("MovingAverage of uint8 = %v, want %v", got, want)
+	}
+}
+
+func TestSumAndAverage(t *testing.T) {
+	if got := Sum[int](nil); got != 0 {
+		t.Errorf("Sum(nil) = %d, want 0", got)
+	}
+	if got := Average[int](nil); got != 0 {
+		t.Errorf("Average(nil) = %v, want 0", got)
+	}
+
+	ints := chainOf(1, 2, 3, 4)
+	if got := Sum(ints[0]); got != 10 {
+		t.Errorf("Sum of ints = %d, want 10", got)
+	}
+	if got := Average(ints[0]); got != 2.5 {
+		t.Errorf("Average of ints = %v, want 2.5", got)
+	}
+
+	bytes := chainOf[uint8](200, 100)
+	if got := Average(bytes[0]); got != 150 {
+		t.Errorf("Average of uint8s = %v, want 150", got)
+	}
+
+	floats := chainOf(0.5, 1.0)
+	closeLoop(floats)
+	if got := Sum(floats[1]); got != 1.5 {
+		t.Errorf("Sum of circular float chain = %v, want 1.5", got)
+	}
+}
@@ -0,0 +1,67 @@
+package lnode
+
+// defaultSlabSize is the number of nodes that a NodePool allocates at once, unless stated otherwise.
+const defaultSlabSize = 64
+
+/*
+NodePool hands out nodes for code that builds and tears down many chains. Instead of allocating nodes one by one, it allocates them in slabs, and it recycles nodes that are returned via Put(). This reduces the pressure on the allocator and the garbage collector compared to calling New() per node. Example:
+
+	pool := lnode.NewNodePool[int](1024)
+	anchor := pool.Get()
+	anchor.Value = 0
+	for i := 1; i < 5; i++ {
+		node := pool.Get()
+		node.Value = i
+		anchor.Tail().Append(node)
+	}
+	...
+	// When done; Put() clears node.Next, so fetch it first:
+	for node := anchor; node != nil; {
+		next := node.Next
+		pool.Put(node)
+		node = next
+	}
+
+The zero value is ready to use and allocates slabs of 64 nodes.
+
+Since the nodes of a slab share one allocation, a slab is only garbage collected when none of its nodes is referenced anymore. A NodePool is not thread-safe: when used by concurrent goroutines, the caller must protect it with a mutex, or use a NodePool per goroutine.
+*/
+type NodePool[V any] struct {
+	slabSize int        // Number of nodes to allocate at once
+	slab     []Node[V]  // Not yet handed out nodes of the current slab
+	free     []*Node[V] // Nodes that were returned via Put()
+}
+
+// NewNodePool returns a NodePool that allocates slabs of slabSize nodes. A slabSize less than 1 selects the default.
+func NewNodePool[V any](slabSize int) *NodePool[V] {
+	return &NodePool[V]{slabSize: slabSize}
+}
+
+// Get returns a node holding the zero value of V, with nil Next and Prev pointers. Previously returned nodes are reused first.
+func (p *NodePool[V]) Get() *Node[V] {
+	if last := len(p.free) - 1; last >= 0 {
+		node := p.free[last]
+		p.free[last] = nil
+		p.free = p.free[:last]
+		return node
+	}
+	if len(p.slab) == 0 {
+		size := p.slabSize
+		if size < 1 {
+			size = defaultSlabSize
+		}
+		p.slab = make([]Node[V], size)
+	}
+	node := &p.slab[0]
+	p.slab = p.slab[1:]
+	return node
+}
+
+// Put returns a node to the pool for reuse. The node is Reset(); it must not be part of a chain anymore, and must not be used by the caller after Put(). A nil node is ignored.
+func (p *NodePool[V]) Put(node *Node[V]) {
+	if node == nil {
+		return
+	}
+	node.Reset()
+	p.free = append(p.free, node)
+}
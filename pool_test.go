@@ -0,0 +1,104 @@
+package lnode
+
+import "testing"
+
+func TestNodePool(t *testing.T) {
+	var pool NodePool[int]
+	seen := map[*Node[int]]bool{}
+	for i := range 2*defaultSlabSize + 1 {
+		node := pool.Get()
+		if node.Value != 0 || node.Next != nil || node.Prev != nil {
+			t.Fatalf("Get() #%d = %+v, want a reset node", i, node)
+		}
+		if seen[node] {
+			t.Fatalf("Get() #%d returned node %p twice", i, node)
+		}
+		seen[node] = true
+	}
+
+	pool = *NewNodePool[int](2)
+	a, b := pool.Get(), pool.Get()
+	a.Value = 1
+	a.Append(b)
+	a.Delete()
+	pool.Put(a)
+	pool.Put(nil)
+	if a.Value != 0 || a.Next != nil || a.Prev != nil {
+		t.Errorf("Put() did not reset the node: %+v", a)
+	}
+	if got := pool.Get(); got != a {
+		t.Errorf("Get() after Put() = %p, want the returned node %p", got, a)
+	}
+	if got := pool.Get(); got == a || got == b {
+		t.Errorf("Get() returned a node that is in use")
+	}
+}
+
+func TestNodePoolPutChain(t *testing.T) {
+	pool := NewNodePool[int](4)
+	anchor := pool.Get()
+	inUse := map[*Node[int]]bool{anchor: true}
+	for i := 1; i < 5; i++ {
+		node := pool.Get()
+		node.Value = i
+		anchor.Tail().Append(node)
+		inUse[node] = true
+	}
+
+	for node := anchor; node != nil; {
+		next := node.Next
+		pool.Put(node)
+		node = next
+	}
+	for node := range inUse {
+		if node.Next != nil || node.Prev != nil || node.Value != 0 {
+			t.Errorf("After putting back the chain: node %p is not reset: %+v", node, node)
+		}
+	}
+
+	for i := range len(inUse) {
+		node := pool.Get()
+		if !inUse[node] {
+			t.Errorf("Get() #%d after putting back the chain = %p, want one of the returned nodes", i, node)
+		}
+		delete(inUse, node)
+	}
+}
+
+func TestReset(t *testing.T) {
+	nodes := chainOf(1, 2, 3)
+	nodes[1].Reset()
+	if nodes[1].Value != 0 || nodes[1].Next != nil || nodes[1].Prev != nil {
+		t.Errorf("Reset() left %+v, want a cleared node", nodes[1])
+	}
+}
+
+func BenchmarkNew(b *testing.B) {
+	for b.Loop() {
+		anchor := New[int](0)
+		tail := anchor
+		for i := 1; i < 1000; i++ {
+			tail.Append(New[int](i))
+			tail = tail.Next
+		}
+	}
+}
+
+func BenchmarkNodePool(b *testing.B) {
+	pool := NewNodePool[int](1024)
+	for b.Loop() {
+		anchor := pool.Get()
+		tail := anchor
+		for i := 1; i < 1000; i++ {
+			node := pool.Get()
+			node.Value = i
+			tail.Append(node)
+			tail = node
+		}
+		for node := anchor; node != nil; {
+			next := node.Next
+			pool.Put(node)
+			node = next
+		}
+	}
+}
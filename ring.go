@@ -0,0 +1,69 @@
+package lnode
+
+/*
+Ring is a buffer of fixed capacity that holds the most recently added values: once it's full, adding a value overwrites the oldest one. It is backed by a circular chain of nodes (see function Circular()) that is allocated once, so adding values doesn't allocate. Example:
+
+	r := lnode.NewRing[int](3)
+	for i := range 5 {
+		r.Add(i)
+	}
+	fmt.Println(r.Slice(), r.Len(), r.Cap())
+	// Output: [2 3 4] 3 3
+
+Like the rest of the package, Ring is not thread-safe.
+*/
+type Ring[V any] struct {
+	oldest *Node[V] // Node holding the oldest value
+	next   *Node[V] // Node where the next value goes
+	length int      // Number of values held
+	cap    int      // Number of nodes in the ring
+}
+
+// NewRing returns an empty Ring that can hold capacity values. A capacity less than 1 is treated as 1.
+func NewRing[V any](capacity int) *Ring[V] {
+	capacity = max(capacity, 1)
+	var zero V
+	first := New[V](zero)
+	first.Next = first
+	first.Prev = first
+	for i := 1; i < capacity; i++ {
+		first.Prepend(New[V](zero))
+	}
+	return &Ring[V]{
+		oldest: first,
+		next:   first,
+		cap:    capacity,
+	}
+}
+
+// Add stores a value in the ring. When the ring is full, the oldest value is overwritten.
+func (r *Ring[V]) Add(v V) {
+	r.next.Value = v
+	r.next = r.next.Next
+	if r.length < r.cap {
+		r.length++
+	} else {
+		r.oldest = r.oldest.Next
+	}
+}
+
+// Slice returns the values in the ring, from the oldest to the newest.
+func (r *Ring[V]) Slice() []V {
+	out := make([]V, 0, r.length)
+	node := r.oldest
+	for range r.length {
+		out = append(out, node.Value)
+		node = node.Next
+	}
+	return out
+}
+
+// Len returns the number of values in the ring.
+func (r *Ring[V]) Len() int {
+	return r.length
+}
+
+// Cap returns the number of values that the ring can hold.
+func (r *Ring[V]) Cap() int {
+	return r.cap
+}
@@ -0,0 +1,44 @@
+package lnode
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestRing(t *testing.T) {
+	r := NewRing[int](3)
+	if !r.oldest.Circular() {
+		t.Errorf("NewRing: backing chain is not circular")
+	}
+	if got := r.Cap(); got != 3 {
+		t.Errorf("NewRing(3): Cap() = %d, want 3", got)
+	}
+
+	for i, want := range [][]int{
+		{0},
+		{0, 1},
+		{0, 1, 2},
+		{1, 2, 3},
+		{2, 3, 4},
+		{3, 4, 5},
+		{4, 5, 6},
+	} {
+		r.Add(i)
+		if got := r.Slice(); !slices.Equal(got, want) {
+			t.Errorf("After Add(%d): Slice() = %v, want %v", i, got, want)
+		}
+		if got := r.Len(); got != len(want) {
+			t.Errorf("After Add(%d): Len() = %d, want %d", i, got, len(want))
+		}
+	}
+
+	empty := NewRing[string](0)
+	if empty.Cap() != 1 || empty.Len() != 0 || len(empty.Slice()) != 0 {
+		t.Errorf("NewRing(0): Cap() = %d, Len() = %d, Slice() = %v; want 1, 0, []", empty.Cap(), empty.Len(), empty.Slice())
+	}
+	empty.Add("a")
+	empty.Add("b")
+	if got := empty.Slice(); !slices.Equal(got, []string{"b"}) {
+		t.Errorf("Single-slot ring: Slice() = %v, want [b]", got)
+	}
+}
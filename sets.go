@@ -0,0 +1,95 @@
+package lnode
+
+/*
+Difference returns a new chain holding the values of a that are not present in b, in the order of a. A value that occurs several times in a is kept once per occurrence. The values of b are collected in a set first, so this runs in O(N+M) time. Both chains are walked "to the right" from the given node, like VisitByNext() does. When no values remain, nil is returned.
+
+Example:
+
+	a := lnode.New[int](1)
+	a.Append(New[int](2))
+	a.Next.Append(New[int](3))
+	a.Next.Next.Append(New[int](2))
+	// Structure:
+	// 1 --- 2 --- 3 --- 2
+	// ^a
+
+	b := lnode.New[int](3)
+	diff := lnode.Difference(a, b)
+	// Structure of the new chain:
+	// 1 --- 2 --- 2
+	// ^diff
+*/
+func Difference[V comparable](a, b *Node[V]) *Node[V] {
+	exclude := valueSet(b)
+	var out builder[V]
+	a.VisitByNext(func(node *Node[V]) bool {
+		if _, ok := exclude[node.Value]; !ok {
+			out.add(node.Value)
+		}
+		return true
+	})
+	return out.head
+}
+
+/*
+Union returns a new chain holding every distinct value of a and b: first the values of a in their order, then the values of b that weren't seen yet. Both chains are walked "to the right" from the given node, like VisitByNext() does. When both are nil, nil is returned.
+
+Example:
+
+	// a: 1 --- 2 --- 1
+	// b: 3 --- 2 --- 4
+	union := lnode.Union(a, b)
+	// Structure of the new chain:
+	// 1 --- 2 --- 3 --- 4
+	// ^union
+*/
+func Union[V comparable](a, b *Node[V]) *Node[V] {
+	seen := map[V]struct{}{}
+	var out builder[V]
+	collect := func(node *Node[V]) bool {
+		if _, ok := seen[node.Value]; !ok {
+			seen[node.Value] = struct{}{}
+			out.add(node.Value)
+		}
+		return true
+	}
+	a.VisitByNext(collect)
+	b.VisitByNext(collect)
+	return out.head
+}
+
+/*
+IntersectionSet returns a new chain holding every distinct value that is present in both a and b, in the order of a. Both chains are walked "to the right" from the given node, like VisitByNext() does. When there are no common values, nil is returned.
+
+Example:
+
+	// a: 1 --- 2 --- 3 --- 2
+	// b: 2 --- 4 --- 3
+	common := lnode.IntersectionSet(a, b)
+	// Structure of the new chain:
+	// 2 --- 3
+	// ^common
+*/
+func IntersectionSet[V comparable](a, b *Node[V]) *Node[V] {
+	inB := valueSet(b)
+	var out builder[V]
+	a.VisitByNext(func(node *Node[V]) bool {
+		if _, ok := inB[node.Value]; ok {
+			out.add(node.Value)
+			// Don't add this value again.
+			delete(inB, node.Value)
+		}
+		return true
+	})
+	return out.head
+}
+
+// valueSet returns the set of values from n rightward.
+func valueSet[V comparable](n *Node[V]) map[V]struct{} {
+	set := map[V]struct{}{}
+	n.VisitByNext(func(node *Node[V]) bool {
+		set[node.Value] = struct{}{}
+		return true
+	})
+	return set
+}
@@ -0,0 +1,73 @@
+package lnode
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestDifference(t *testing.T) {
+	for _, test := range []struct {
+		desc string
+		a    []int
+		b    []int
+		want []int
+	}{
+		{desc: "empty a", b: []int{1, 2}, want: nil},
+		{desc: "empty b", a: []int{1, 2, 1}, want: []int{1, 2, 1}},
+		{desc: "some removed", a: []int{1, 2, 3, 2, 4}, b: []int{3, 1}, want: []int{2, 2, 4}},
+		{desc: "all removed", a: []int{1, 2, 1}, b: []int{2, 1}, want: nil},
+		{desc: "disjoint", a: []int{1, 2}, b: []int{3, 4}, want: []int{1, 2}},
+	} {
+		var a, b *Node[int]
+		if len(test.a) > 0 {
+			a = chainOf(test.a...)[0]
+		}
+		if len(test.b) > 0 {
+			b = chainOf(test.b...)[0]
+		}
+		got := Difference(a, b)
+		if test.want == nil {
+			if got != nil {
+				t.Errorf("Difference: %s: got %v, want nil", test.desc, valuesOf(got))
+			}
+			continue
+		}
+		if vals := valuesOf(got); !slices.Equal(vals, test.want) || !wellLinked(got) {
+			t.Errorf("Difference: %s: got %v, want %v", test.desc, vals, test.want)
+		}
+		if a != nil && !slices.Equal(valuesOf(a), test.a) {
+			t.Errorf("Difference: %s: input a changed to %v", test.desc, valuesOf(a))
+		}
+	}
+}
+
+func TestUnionAndIntersectionSet(t *testing.T) {
+	for _, test := range []struct {
+		desc         string
+		a            []int
+		b            []int
+		union        []int
+		intersection []int
+	}{
+		{desc: "both empty"},
+		{desc: "empty a", b: []int{1, 2, 1}, union: []int{1, 2}},
+		{desc: "empty b", a: []int{2, 2, 1}, union: []int{2, 1}},
+		{desc: "overlap", a: []int{1, 2, 1, 3}, b: []int{4, 3, 2, 4}, union: []int{1, 2, 3, 4}, intersection: []int{2, 3}},
+		{desc: "disjoint", a: []int{1, 2}, b: []int{3, 4}, union: []int{1, 2, 3, 4}},
+		{desc: "same", a: []int{3, 1, 3}, b: []int{1, 3}, union: []int{3, 1}, intersection: []int{3, 1}},
+	} {
+		var a, b *Node[int]
+		if len(test.a) > 0 {
+			a = chainOf(test.a...)[0]
+		}
+		if len(test.b) > 0 {
+			b = chainOf(test.b...)[0]
+		}
+		if got := valuesOf(Union(a, b)); !slices.Equal(got, test.union) {
+			t.Errorf("Union: %s: got %v, want %v", test.desc, got, test.union)
+		}
+		if got := valuesOf(IntersectionSet(a, b)); !slices.Equal(got, test.intersection) {
+			t.Errorf("IntersectionSet: %s: got %v, want %v", test.desc, got, test.intersection)
+		}
+	}
+}
@@ -0,0 +1,312 @@
+package lnode
+
+import (
+	"cmp"
+	"container/heap"
+	"slices"
+)
+
+/*
+InsertSortedUnique inserts a value into a chain that is sorted according to less, unless an equal value is already present. Two values a and b are considered equal when neither less(a, b) nor less(b, a) holds. The chain is scanned from its head, so n may be any node in the chain. InsertSortedUnique returns the head of the chain, which is a new node when value sorts before all others.
+
+When n is nil, a new single-node chain is returned. Circular chains have no head; for these, nil is returned and nothing is inserted.
+
+Example:
+
+	less := func(a, b int) bool { return a < b }
+	var head *lnode.Node[int]
+	for _, v := range []int{3, 1, 2, 3, 1} {
+		head = lnode.InsertSortedUnique(head, v, less)
+	}
+	// Structure:
+	// 1 --- 2 --- 3
+	// ^head
+*/
+func InsertSortedUnique[V comparable](n *Node[V], value V, less func(a, b V) bool) *Node[V] {
+	if n == nil {
+		return New[V](value)
+	}
+	head := n.Head()
+	if head == nil {
+		return nil
+	}
+
+	var last *Node[V]
+	for node := head; node != nil; node = node.Next {
+		if !less(node.Value, value) {
+			if !less(value, node.Value) {
+				// Equal value already present.
+				return head
+			}
+			node.Prepend(New[V](value))
+			if node == head {
+				return node.Prev
+			}
+			return head
+		}
+		last = node
+	}
+	last.Append(New[V](value))
+	return head
+}
+
+/*
+InsertSortedBatch inserts values into a chain that is sorted according to less, and returns the head of the chain. The chain must already be sorted; this isn't checked. Rather than walking the chain for every value, a sorted copy of values is merged into the chain in a single pass, so this takes O(M log M + N) time for M values and N nodes, instead of O(M*N). A value that equals values in the chain is inserted after them, and values that equal each other keep their order. The chain is scanned from its head, so n may be any node in the chain.
+
+When n is nil, a new chain holding the sorted values is returned. Circular chains have no head; for these, nil is returned and nothing is inserted.
+
+Example:
+
+	// anchor: 1 --- 4 --- 7
+	head := lnode.InsertSortedBatch(anchor, []int{8, 0, 5}, func(a, b int) bool { return a < b })
+	// New structure:
+	// 0 --- 1 --- 4 --- 5 --- 7 --- 8
+	// ^head ^anchor
+*/
+func InsertSortedBatch[V any](n *Node[V], values []V, less func(a, b V) bool) *Node[V] {
+	sorted := slices.Clone(values)
+	slices.SortStableFunc(sorted, func(a, b V) int {
+		switch {
+		case less(a, b):
+			return -1
+		case less(b, a):
+			return 1
+		}
+		return 0
+	})
+	if n == nil {
+		head, _ := BuildChain(sorted)
+		return head
+	}
+	head := n.Head()
+	if head == nil {
+		return nil
+	}
+
+	var last *Node[V]
+	node := head
+	for _, v := range sorted {
+		for node != nil && !less(v, node.Value) {
+			last = node
+			node = node.Next
+		}
+		added := New[V](v)
+		if last == nil {
+			node.Prepend(added)
+			head = added
+		} else {
+			last.Append(added)
+		}
+		last = added
+	}
+	return head
+}
+
+/*
+Compare compares the values of chains a and b element by element, starting at the given nodes and going "to the right", like slices.Compare. The result is 0 when the chains hold equal values, -1 when a sorts before b, and +1 otherwise. When one chain is a prefix of the other, the shorter one sorts first. A nil node is an empty chain. Circular chains are compared like VisitByNext() walks them: every node is considered once.
+
+Example:
+
+	// a: 1 --- 2 --- 3
+	// b: 1 --- 2 --- 4
+	fmt.Println(lnode.Compare(a, b), lnode.Compare(b, a), lnode.Compare(a, a))
+	// Output: -1 1 0
+*/
+func Compare[V cmp.Ordered](a, b *Node[V]) int {
+	na, nb := a, b
+	for na != nil && nb != nil {
+		if c := cmp.Compare(na.Value, nb.Value); c != 0 {
+			return c
+		}
+		na, nb = na.advance(a), nb.advance(b)
+	}
+	switch {
+	case na == nil && nb == nil:
+		return 0
+	case na == nil:
+		return -1
+	default:
+		return 1
+	}
+}
+
+/*
+SortedSearch scans a chain that is sorted according to less, starting at n and going "to the right", for the position where target belongs. It returns the first node whose value doesn't sort before target, or nil when all values do. The bool is true when that node holds a value equal to target, i.e., neither sorts before the other. Since a linked list can't be bisected, this is a linear scan that stops as soon as the position is found. Example:
+
+	// anchor: 1 --- 3 --- 5
+	node, found := lnode.SortedSearch(anchor, 3, less)  // node holds 3, found is true
+	node, found = lnode.SortedSearch(anchor, 4, less)   // node holds 5, found is false
+	node, found = lnode.SortedSearch(anchor, 6, less)   // node is nil, found is false
+*/
+func SortedSearch[V any](n *Node[V], target V, less func(a, b V) bool) (*Node[V], bool) {
+	node := n.Find(func(v V) bool { return !less(v, target) })
+	if node == nil {
+		return nil, false
+	}
+	return node, !less(target, node.Value)
+}
+
+/*
+IsSorted returns true when the values of the chain, starting at n and going "to the right", are in non-decreasing order according to less, like slices.IsSortedFunc. The check stops at the first pair that is out of order. A nil node and a single node are sorted. In a circular chain, the check stops before reaching n again. Example:
+
+	// anchor: 1 --- 2 --- 2 --- 3
+	fmt.Println(lnode.IsSorted(anchor, func(a, b int) bool { return a < b }))
+	// Output: true
+*/
+func IsSorted[V any](n *Node[V], less func(a, b V) bool) bool {
+	if n == nil {
+		return true
+	}
+	for node, next := n, n.advance(n); next != nil; node, next = next, next.advance(n) {
+		if less(next.Value, node.Value) {
+			return false
+		}
+	}
+	return true
+}
+
+/*
+CountInversions returns the number of pairs of values in the chain, starting at n and going "to the right", that are out of order according to less: a value that sorts before a value preceding it. This measures how unsorted a chain is: a sorted chain has no inversions, a reversed one has N*(N-1)/2. The values are copied into a slice and counted with a merge sort, which takes O(N log N) time and O(N) space. Circular chains are handled like VisitByNext() does: every node is considered once. Example:
+
+	// anchor: 3 --- 1 --- 2
+	fmt.Println(lnode.CountInversions(anchor, func(a, b int) bool { return a < b }))
+	// Output: 2
+*/
+func CountInversions[V any](n *Node[V], less func(a, b V) bool) int {
+	values := n.ToSlice()
+	return countInversions(values, make([]V, len(values)), less)
+}
+
+// countInversions sorts values according to less, using buf as scratch space of the same length, and returns the number of inversions that it resolved.
+func countInversions[V any](values, buf []V, less func(a, b V) bool) int {
+	if len(values) < 2 {
+		return 0
+	}
+	mid := len(values) / 2
+	count := countInversions(values[:mid], buf[:mid], less) + countInversions(values[mid:], buf[mid:], less)
+
+	merged := buf[:0]
+	i, j := 0, mid
+	for i < mid && j < len(values) {
+		if less(values[j], values[i]) {
+			// values[j] sorts before all remaining values of the left half.
+			count += mid - i
+			merged = append(merged, values[j])
+			j++
+		} else {
+			merged = append(merged, values[i])
+			i++
+		}
+	}
+	merged = append(merged, values[i:mid]...)
+	merged = append(merged, values[j:]...)
+	copy(values, merged)
+	return count
+}
+
+/*
+Partition3 rearranges the chain that starts at n and extends "to the right" into three separate chains: the nodes whose values sort before pivot according to less, the ones equal to pivot, and the ones sorting after it. It returns the heads of these three chains; each is nil when it receives no nodes. The nodes are relinked, not copied, so references to them stay valid, and the relative order within each chain is preserved. This is the partition step of a quicksort over linked nodes (the "Dutch national flag" partition).
+
+Nodes "to the left" of n are cut off and stay linked to each other. Circular chains are handled like VisitByNext() does: every node is included once.
+
+Example:
+
+	// anchor: 3 --- 1 --- 4 --- 1 --- 5 --- 9 --- 2
+	lt, eq, gt := lnode.Partition3(anchor, 3, func(a, b int) bool { return a < b })
+	// New structures:
+	// 1 --- 1 --- 2          lt
+	// 3                      eq
+	// 4 --- 5 --- 9          gt
+*/
+func Partition3[V any](n *Node[V], pivot V, less func(a, b V) bool) (lt, eq, gt *Node[V]) {
+	if n == nil {
+		return nil, nil, nil
+	}
+	if n.Prev != nil && n.Prev.Next == n {
+		n.Prev.Next = nil
+	}
+	var ltb, eqb, gtb builder[V]
+	for _, node := range n.Nodes() {
+		switch {
+		case less(node.Value, pivot):
+			ltb.link(node)
+		case less(pivot, node.Value):
+			gtb.link(node)
+		default:
+			eqb.link(node)
+		}
+	}
+	return ltb.head, eqb.head, gtb.head
+}
+
+/*
+MergeK merges any number of chains, each sorted according to less, into a new sorted chain, and returns its head. The values are copied; the chains themselves are left untouched. The current node of every chain is kept in a heap, so that merging takes O(N log K) time, N being the total number of values and K the number of chains. This suits e.g. external merge sorts with many runs. Nil chains are ignored; when there are no values at all, nil is returned.
+
+The merge is stable: equal values keep their order within a chain, and of equal values from different chains, those of the chain that comes first in the arguments come first. Every chain is walked "to the right" from the given node; circular chains are handled like VisitByNext() does.
+
+Example:
+
+	// a: 1 --- 4 --- 7
+	// b: 2 --- 5
+	// c: 3 --- 6
+	merged := lnode.MergeK(func(x, y int) bool { return x < y }, a, b, c)
+	// Structure of the new chain:
+	// 1 --- 2 --- 3 --- 4 --- 5 --- 6 --- 7
+	// ^merged
+*/
+func MergeK[V any](less func(a, b V) bool, chains ...*Node[V]) *Node[V] {
+	h := &mergeHeap[V]{less: less}
+	for i, chain := range chains {
+		if chain != nil {
+			h.cursors = append(h.cursors, mergeCursor[V]{node: chain, start: chain, order: i})
+		}
+	}
+	heap.Init(h)
+
+	var out builder[V]
+	for h.Len() > 0 {
+		c := &h.cursors[0]
+		out.add(c.node.Value)
+		if c.node = c.node.advance(c.start); c.node != nil {
+			heap.Fix(h, 0)
+		} else {
+			heap.Pop(h)
+		}
+	}
+	return out.head
+}
+
+// mergeCursor is the position of MergeK() in one of its chains. order is the position of the chain in the arguments, which breaks ties.
+type mergeCursor[V any] struct {
+	node, start *Node[V]
+	order       int
+}
+
+// mergeHeap implements heap.Interface for MergeK(), ordering the cursors by their current value.
+type mergeHeap[V any] struct {
+	cursors []mergeCursor[V]
+	less    func(a, b V) bool
+}
+
+func (h *mergeHeap[V]) Len() int { return len(h.cursors) }
+
+func (h *mergeHeap[V]) Less(i, j int) bool {
+	a, b := h.cursors[i], h.cursors[j]
+	if h.less(a.node.Value, b.node.Value) {
+		return true
+	}
+	if h.less(b.node.Value, a.node.Value) {
+		return false
+	}
+	return a.order < b.order
+}
+
+func (h *mergeHeap[V]) Swap(i, j int) { h.cursors[i], h.cursors[j] = h.cursors[j], h.cursors[i] }
+
+func (h *mergeHeap[V]) Push(x any) { h.cursors = append(h.cursors, x.(mergeCursor[V])) }
+
+func (h *mergeHeap[V]) Pop() any {
+	last := h.cursors[len(h.cursors)-1]
+	h.cursors = h.cursors[:len(h.cursors)-1]
+	return last
+}
@@ -0,0 +1,278 @@
+package lnode
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestInsertSortedUnique(t *testing.T) {
+	less := func(a, b int) bool { return a < b }
+
+	var head *Node[int]
+	for _, v := range []int{5, 3, 7, 3, 1, 9, 5, 6, 9, 1} {
+		head = InsertSortedUnique(head, v, less)
+		if head.Prev != nil {
+			t.Errorf("InsertSortedUnique(%d): returned node is not the head", v)
+		}
+	}
+	want := []int{1, 3, 5, 6, 7, 9}
+	if got := valuesOf(head); !slices.Equal(got, want) {
+		t.Errorf("InsertSortedUnique: got %v, want %v", got, want)
+	}
+	if !wellLinked(head) {
+		t.Errorf("InsertSortedUnique: Prev pointers are inconsistent")
+	}
+
+	// Inserting via a node that is not the head
+	head = InsertSortedUnique(head.Tail(), 4, less)
+	want = []int{1, 3, 4, 5, 6, 7, 9}
+	if got := valuesOf(head); !slices.Equal(got, want) {
+		t.Errorf("InsertSortedUnique via tail: got %v, want %v", got, want)
+	}
+
+	// Circular chains are refused
+	nodes := chainOf(1, 2, 3)
+	closeLoop(nodes)
+	if got := InsertSortedUnique(nodes[0], 0, less); got != nil {
+		t.Errorf("InsertSortedUnique on circular chain: got %v, want nil", got)
+	}
+}
+
+func TestInsertSortedBatch(t *testing.T) {
+	less := func(a, b int) bool { return a < b }
+	for _, test := range []struct {
+		chain  []int
+		values []int
+		want   []int
+	}{
+		{chain: []int{1, 4, 7}, values: []int{8, 0, 5}, want: []int{0, 1, 4, 5, 7, 8}},
+		{chain: []int{1, 4, 7}, values: []int{4, 4, 1}, want: []int{1, 1, 4, 4, 4, 7}},
+		{chain: []int{5}, values: []int{3, 1, 2}, want: []int{1, 2, 3, 5}},
+		{chain: []int{1, 2}, values: nil, want: []int{1, 2}},
+	} {
+		nodes := chainOf(test.chain...)
+		head := InsertSortedBatch(nodes[len(nodes)-1], test.values, less)
+		if got := valuesOf(head); !slices.Equal(got, test.want) || head.Prev != nil || !wellLinked(head) {
+			t.Errorf("InsertSortedBatch(%v, %v) = %v, want %v", test.chain, test.values, got, test.want)
+		}
+	}
+
+	// Equal values go after those in the chain, and keep their order.
+	type item struct{ key, id int }
+	byKey := func(a, b item) bool { return a.key < b.key }
+	items := chainOf(item{1, 0}, item{2, 0})
+	head := InsertSortedBatch(items[0], []item{{2, 2}, {1, 1}, {2, 1}}, byKey)
+	want := []item{{1, 0}, {1, 1}, {2, 0}, {2, 2}, {2, 1}}
+	if got := valuesOf(head); !slices.Equal(got, want) {
+		t.Errorf("InsertSortedBatch with ties = %v, want %v", got, want)
+	}
+
+	values := []int{3, 1, 2}
+	if got := valuesOf(InsertSortedBatch(nil, values, less)); !slices.Equal(got, []int{1, 2, 3}) || !slices.Equal(values, []int{3, 1, 2}) {
+		t.Errorf("InsertSortedBatch into nil chain = %v, want [1 2 3] with values untouched", got)
+	}
+
+	ring := chainOf(1, 2)
+	closeLoop(ring)
+	if got := InsertSortedBatch(ring[0], []int{0}, less); got != nil || ring[0].RingLen() != 2 {
+		t.Errorf("InsertSortedBatch into circular chain = %v, want nil and no change", got)
+	}
+}
+
+func TestCompare(t *testing.T) {
+	for _, test := range []struct {
+		a, b []int
+		want int
+	}{
+		{a: nil, b: nil, want: 0},
+		{a: nil, b: []int{1}, want: -1},
+		{a: []int{1}, b: nil, want: 1},
+		{a: []int{1, 2, 3}, b: []int{1, 2, 3}, want: 0},
+		{a: []int{1, 2, 3}, b: []int{1, 2, 4}, want: -1},
+		{a: []int{1, 3}, b: []int{1, 2, 4}, want: 1},
+		{a: []int{1, 2}, b: []int{1, 2, 3}, want: -1},
+		{a: []int{1, 2, 3}, b: []int{1, 2}, want: 1},
+	} {
+		var a, b *Node[int]
+		if len(test.a) > 0 {
+			a = chainOf(test.a...)[0]
+		}
+		if len(test.b) > 0 {
+			b = chainOf(test.b...)[0]
+		}
+		if got := Compare(a, b); got != test.want {
+			t.Errorf("Compare(%v, %v) = %d, want %d", test.a, test.b, got, test.want)
+		}
+	}
+
+	ring := chainOf("a", "b")
+	closeLoop(ring)
+	if got := Compare(ring[0], chainOf("a", "b")[0]); got != 0 {
+		t.Errorf("Compare(circular [a b], [a b]) = %d, want 0", got)
+	}
+	if got := Compare(ring[0], chainOf("a", "b", "a")[0]); got != -1 {
+		t.Errorf("Compare(circular [a b], [a b a]) = %d, want -1", got)
+	}
+}
+
+func TestSortedSearch(t *testing.T) {
+	less := func(a, b int) bool { return a < b }
+	nodes := chainOf(1, 3, 3, 5)
+	for _, test := range []struct {
+		target    int
+		wantNode  *Node[int]
+		wantFound bool
+	}{
+		{target: 0, wantNode: nodes[0], wantFound: false},
+		{target: 1, wantNode: nodes[0], wantFound: true},
+		{target: 3, wantNode: nodes[1], wantFound: true},
+		{target: 4, wantNode: nodes[3], wantFound: false},
+		{target: 5, wantNode: nodes[3], wantFound: true},
+		{target: 6, wantNode: nil, wantFound: false},
+	} {
+		node, found := SortedSearch(nodes[0], test.target, less)
+		if node != test.wantNode || found != test.wantFound {
+			t.Errorf("SortedSearch(%d) = %v, %v; want %v, %v", test.target, node, found, test.wantNode, test.wantFound)
+		}
+	}
+	if node, found := SortedSearch[int](nil, 1, less); node != nil || found {
+		t.Errorf("SortedSearch(nil) = %v, %v; want nil, false", node, found)
+	}
+}
+
+func TestIsSorted(t *testing.T) {
+	less := func(a, b int) bool { return a < b }
+	if !IsSorted[int](nil, less) {
+		t.Errorf("IsSorted(nil) = false, want true")
+	}
+	for _, test := range []struct {
+		values []int
+		want   bool
+	}{
+		{values: []int{1}, want: true},
+		{values: []int{1, 2, 2, 3}, want: true},
+		{values: []int{2, 1}, want: false},
+		{values: []int{1, 2, 3, 2}, want: false},
+	} {
+		if got := IsSorted(chainOf(test.values...)[0], less); got != test.want {
+			t.Errorf("IsSorted(%v) = %v, want %v", test.values, got, test.want)
+		}
+	}
+
+	// The wrap-around from 3 back to 1 doesn't count.
+	nodes := chainOf(1, 2, 3)
+	closeLoop(nodes)
+	if !IsSorted(nodes[0], less) {
+		t.Errorf("IsSorted of circular chain from its lowest value = false, want true")
+	}
+	if IsSorted(nodes[1], less) {
+		t.Errorf("IsSorted of circular chain from the middle = true, want false")
+	}
+}
+
+func TestCountInversions(t *testing.T) {
+	less := func(a, b int) bool { return a < b }
+	for _, test := range []struct {
+		values []int
+		want   int
+	}{
+		{values: []int{1}, want: 0},
+		{values: []int{1, 2, 3, 4}, want: 0},
+		{values: []int{3, 1, 2}, want: 2},
+		{values: []int{4, 3, 2, 1}, want: 6},
+		{values: []int{2, 2, 1, 2}, want: 2},
+		{values: []int{5, 1, 4, 2, 3, 0}, want: 11},
+	} {
+		nodes := chainOf(test.values...)
+		if got := CountInversions(nodes[0], less); got != test.want {
+			t.Errorf("CountInversions(%v) = %d, want %d", test.values, got, test.want)
+		}
+		if got := valuesOf(nodes[0]); !slices.Equal(got, test.values) {
+			t.Errorf("CountInversions(%v): chain changed to %v", test.values, got)
+		}
+	}
+
+	nodes := chainOf(1, 2, 3)
+	closeLoop(nodes)
+	if got := CountInversions(nodes[1], less); got != 2 {
+		t.Errorf("CountInversions of circular chain from 2 = %d, want 2", got)
+	}
+	if got := CountInversions(nil, less); got != 0 {
+		t.Errorf("CountInversions of nil node = %d, want 0", got)
+	}
+}
+
+func TestPartition3(t *testing.T) {
+	less := func(a, b int) bool { return a < b }
+	nodes := chainOf(3, 1, 4, 1, 5, 9, 2, 6, 5, 3)
+	lt, eq, gt := Partition3(nodes[0], 3, less)
+	for _, test := range []struct {
+		desc string
+		head *Node[int]
+		want []int
+	}{
+		{desc: "lt", head: lt, want: []int{1, 1, 2}},
+		{desc: "eq", head: eq, want: []int{3, 3}},
+		{desc: "gt", head: gt, want: []int{4, 5, 9, 6, 5}},
+	} {
+		if got := valuesOf(test.head); !slices.Equal(got, test.want) {
+			t.Errorf("Partition3 %s = %v, want %v", test.desc, got, test.want)
+		}
+		if test.head.Prev != nil || test.head.Tail().Next != nil || !wellLinked(test.head) {
+			t.Errorf("Partition3 %s: chain is malformed", test.desc)
+		}
+	}
+	if lt != nodes[1] || eq != nodes[0] || gt != nodes[2] {
+		t.Errorf("Partition3: heads are not the original nodes")
+	}
+
+	lt, eq, gt = Partition3(chainOf(1, 2)[0], 5, less)
+	if lt == nil || eq != nil || gt != nil {
+		t.Errorf("Partition3 with all values below pivot = %v, %v, %v; want only lt", lt, eq, gt)
+	}
+
+	// Nodes before the start are cut off.
+	nodes = chainOf(7, 1, 8)
+	lt, _, gt = Partition3(nodes[1], 5, less)
+	if nodes[0].Next != nil || lt != nodes[1] || gt != nodes[2] {
+		t.Errorf("Partition3 from the middle: preceding node not cut off or wrong heads")
+	}
+
+	nodes = chainOf(2, 5, 8)
+	closeLoop(nodes)
+	lt, eq, gt = Partition3(nodes[0], 5, less)
+	if lt != nodes[0] || eq != nodes[1] || gt != nodes[2] || gt.Next != nil || lt.Prev != nil {
+		t.Errorf("Partition3 of circular chain: ring not split into single nodes")
+	}
+}
+
+func TestMergeK(t *testing.T) {
+	less := func(a, b int) bool { return a < b }
+	a, b, c := chainOf(1, 4, 7), chainOf(2, 5), chainOf(3, 6)
+	got := MergeK(less, a[0], nil, b[0], c[0])
+	if vals := valuesOf(got); !slices.Equal(vals, []int{1, 2, 3, 4, 5, 6, 7}) || !wellLinked(got) {
+		t.Errorf("MergeK = %v, want [1 2 3 4 5 6 7]", vals)
+	}
+	if vals := valuesOf(a[0]); !slices.Equal(vals, []int{1, 4, 7}) {
+		t.Errorf("MergeK: input changed to %v", vals)
+	}
+
+	// Stability: ties are taken in argument order.
+	type item struct{ key, chain int }
+	byKey := func(x, y item) bool { return x.key < y.key }
+	x := chainOf(item{1, 0}, item{2, 0}, item{2, 0})
+	y := chainOf(item{1, 1}, item{2, 1})
+	want := []item{{1, 0}, {1, 1}, {2, 0}, {2, 0}, {2, 1}}
+	if vals := valuesOf(MergeK(byKey, x[0], y[0])); !slices.Equal(vals, want) {
+		t.Errorf("MergeK with ties = %v, want %v", vals, want)
+	}
+
+	ring := chainOf(2, 4)
+	closeLoop(ring)
+	if vals := valuesOf(MergeK(less, ring[0], chainOf(3)[0])); !slices.Equal(vals, []int{2, 3, 4}) {
+		t.Errorf("MergeK with circular chain = %v, want [2 3 4]", vals)
+	}
+	if got := MergeK[int](less); got != nil {
+		t.Errorf("MergeK without chains = %v, want nil", got)
+	}
+}
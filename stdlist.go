@@ -0,0 +1,43 @@
+package lnode
+
+import "container/list"
+
+/*
+ToStdList copies the values of the node and all next nodes ("to the right") into a new list.List from the standard library, in order. Circular chains are handled like VisitByNext() does: every node is copied once. Example:
+
+	l := anchor.ToStdList()
+	for e := l.Front(); e != nil; e = e.Next() {
+		fmt.Println(e.Value.(int))
+	}
+*/
+func (n *Node[V]) ToStdList() *list.List {
+	l := list.New()
+	n.EachValue(func(v V) {
+		l.PushBack(v)
+	})
+	return l
+}
+
+/*
+FromStdList copies the values of a list.List from the standard library into a new chain, in order, and returns its head. When l is nil or empty, nil is returned. Example:
+
+	l := list.New()
+	l.PushBack("a")
+	l.PushBack("b")
+	anchor := lnode.FromStdList[string](l)
+	// Structure:
+	// a --- b
+	// ^anchor
+
+Since list.List holds values of type any, every element is converted using a type assertion to V. FromStdList panics when an element holds a value of another type, just like a failing type assertion does.
+*/
+func FromStdList[V any](l *list.List) *Node[V] {
+	if l == nil {
+		return nil
+	}
+	var b builder[V]
+	for e := l.Front(); e != nil; e = e.Next() {
+		b.add(e.Value.(V))
+	}
+	return b.head
+}
@@ -0,0 +1,44 @@
+package lnode
+
+import (
+	"container/list"
+	"slices"
+	"testing"
+)
+
+func TestStdList(t *testing.T) {
+	nodes := chainOf("a", "b", "c")
+	l := nodes[0].ToStdList()
+	var got []string
+	for e := l.Front(); e != nil; e = e.Next() {
+		got = append(got, e.Value.(string))
+	}
+	if want := []string{"a", "b", "c"}; !slices.Equal(got, want) {
+		t.Errorf("ToStdList: got %v, want %v", got, want)
+	}
+
+	back := FromStdList[string](l)
+	if got, want := valuesOf(back), []string{"a", "b", "c"}; !slices.Equal(got, want) || !wellLinked(back) {
+		t.Errorf("FromStdList: got %v, want %v", got, want)
+	}
+
+	if got := FromStdList[string](nil); got != nil {
+		t.Errorf("FromStdList(nil) = %v, want nil", got)
+	}
+	if got := FromStdList[string](list.New()); got != nil {
+		t.Errorf("FromStdList(empty list) = %v, want nil", got)
+	}
+	var empty *Node[int]
+	if got := empty.ToStdList(); got.Len() != 0 {
+		t.Errorf("ToStdList of nil node: got length %d, want 0", got.Len())
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("FromStdList with mismatching element type: no panic")
+		}
+	}()
+	mixed := list.New()
+	mixed.PushBack(1)
+	FromStdList[string](mixed)
+}
@@ -0,0 +1,355 @@
+package lnode
+
+import "sync"
+
+/*
+Tee returns copies independent clones of the chain, starting at n and going "to the right". This is useful to feed the same sequence to several consumers that each modify their own copy. The source is walked once, and all clones are built in the same pass. When the source is circular (see function Circular()), so are the clones. The returned slice has copies elements; each is nil when n is nil.
+
+Example:
+
+	anchor := lnode.New[int](0)
+	anchor.Append(New[int](1))
+
+	clones := lnode.Tee(anchor, 2)
+	clones[0].Value = 10
+	// Structures:
+	// 0 --- 1     anchor
+	// 10 --- 1    clones[0]
+	// 0 --- 1     clones[1]
+*/
+func Tee[V any](n *Node[V], copies int) []*Node[V] {
+	if copies <= 0 {
+		return []*Node[V]{}
+	}
+	builders := make([]builder[V], copies)
+	circular := false
+	n.VisitByNext(func(node *Node[V]) bool {
+		for i := range builders {
+			builders[i].add(node.Value)
+		}
+		circular = node.Next == n
+		return true
+	})
+
+	out := make([]*Node[V], copies)
+	for i, b := range builders {
+		if circular {
+			b.tail.Next = b.head
+			b.head.Prev = b.tail
+		}
+		out[i] = b.head
+	}
+	return out
+}
+
+/*
+Scan folds the values of the chain, starting at n and going "to the right", into an accumulator, and returns a new chain holding every intermediate accumulator value, e.g. running totals. The initial value init is not included: the first node of the result holds fn(init, n.Value), so that the result has as many nodes as the input. When n is nil, nil is returned. Circular chains are handled like VisitByNext() does: every node is visited once.
+
+Example:
+
+	anchor := lnode.New[int](1)
+	anchor.Append(New[int](2))
+	anchor.Next.Append(New[int](3))
+
+	totals := lnode.Scan(anchor, 0, func(acc, v int) int { return acc + v })
+	// Structure of the new chain:
+	// 1 --- 3 --- 6
+	// ^totals
+*/
+func Scan[V any, A any](n *Node[V], init A, fn func(A, V) A) *Node[A] {
+	acc := init
+	var out builder[A]
+	n.VisitByNext(func(node *Node[V]) bool {
+		acc = fn(acc, node.Value)
+		out.add(acc)
+		return true
+	})
+	return out.head
+}
+
+/*
+Pluck returns a new chain holding a projection of each value, e.g. one field of a struct, selected by sel. The chain is walked starting at n and going "to the right"; circular chains are handled like VisitByNext() does, and yield a linear result. When n is nil, nil is returned.
+
+Example:
+
+	type person struct {
+		name string
+		age  int
+	}
+	// people: {"Alice", 30} --- {"Bob", 25}
+	names := lnode.Pluck(people, func(p person) string { return p.name })
+	// Structure of the new chain:
+	// "Alice" --- "Bob"
+	// ^names
+*/
+func Pluck[V any, F any](n *Node[V], sel func(V) F) *Node[F] {
+	var out builder[F]
+	n.EachValue(func(v V) {
+		out.add(sel(v))
+	})
+	return out.head
+}
+
+/*
+Interleave returns a new chain that alternately takes a value from a and from b, starting with a. When one chain is longer, its remaining values are appended at the end. Both chains are walked "to the right" from the given node; circular chains are handled like VisitByNext() does. When one of a and b is nil, the result is a copy of the other. When both are nil, nil is returned.
+
+Example:
+
+	// a: 1 --- 2 --- 3 --- 4
+	// b: 10 --- 20
+	mixed := lnode.Interleave(a, b)
+	// Structure of the new chain:
+	// 1 --- 10 --- 2 --- 20 --- 3 --- 4
+	// ^mixed
+*/
+func Interleave[V any](a, b *Node[V]) *Node[V] {
+	var out builder[V]
+	na, nb := a, b
+	for na != nil || nb != nil {
+		if na != nil {
+			out.add(na.Value)
+			na = na.advance(a)
+		}
+		if nb != nil {
+			out.add(nb.Value)
+			nb = nb.advance(b)
+		}
+	}
+	return out.head
+}
+
+/*
+DedupFunc removes nodes whose value equals that of the node before them, according to eq, so that of every run of adjacent equal values only the first node is kept. This works for values that aren't comparable, such as structs holding slices. The chain is modified in place, starting at n and going "to the right". The removed nodes have their Next and Prev pointers cleared.
+
+DedupFunc returns the head of the chain. In a circular chain, which has no head, the walk stops before reaching n again and n is returned.
+
+Example:
+
+	// anchor: 1 --- 1 --- 2 --- 2 --- 2 --- 1
+	head := lnode.DedupFunc(anchor, func(a, b int) bool { return a == b })
+	// New structure:
+	// 1 --- 2 --- 1
+	// ^head
+*/
+func DedupFunc[V any](n *Node[V], eq func(a, b V) bool) *Node[V] {
+	if n == nil {
+		return nil
+	}
+	node := n
+	for next := node.advance(n); next != nil; next = node.advance(n) {
+		if eq(node.Value, next.Value) {
+			next.detach()
+		} else {
+			node = next
+		}
+	}
+	return n.headOrSelf()
+}
+
+/*
+Coalesce merges adjacent values: whenever canMerge reports that a node's value can absorb the value of the node after it, the node gets the value that merge returns, and the node after it is removed. This repeats until no adjacent pair can merge, including pairs that only become mergeable after an earlier merge. This is useful to merge overlapping intervals, or to join consecutive tokens. The chain is modified in place, starting at n and going "to the right". Every merge removes a node, so Coalesce terminates after at most N merges. The removed nodes have their Next and Prev pointers cleared.
+
+Coalesce returns the head of the chain. In a circular chain, which has no head, the walk stops before reaching n again, so the last node and n aren't merged, and n is returned.
+
+Example:
+
+	type interval struct{ from, to int }
+	// anchor: {1 3} --- {2 5} --- {7 8} --- {8 9}
+	head := lnode.Coalesce(anchor,
+		func(a, b interval) bool { return b.from <= a.to },
+		func(a, b interval) interval { return interval{a.from, max(a.to, b.to)} })
+	// New structure:
+	// {1 5} --- {7 9}
+	// ^head
+*/
+func Coalesce[V any](n *Node[V], canMerge func(a, b V) bool, merge func(a, b V) V) *Node[V] {
+	if n == nil {
+		return nil
+	}
+	node := n
+	for next := node.advance(n); next != nil; next = node.advance(n) {
+		if !canMerge(node.Value, next.Value) {
+			node = next
+			continue
+		}
+		node.Value = merge(node.Value, next.Value)
+		next.detach()
+		// The merged value may now merge with the value before it.
+		if node != n {
+			node = node.Prev
+		}
+	}
+	return n.headOrSelf()
+}
+
+/*
+DedupByKey removes nodes whose key, as computed by key, was already seen in an earlier node, so that of all nodes sharing a key only the first is kept. Unlike DedupFunc(), the duplicates needn't be adjacent. Since only the keys need to be comparable, this works for values that aren't. The chain is modified in place, starting at n and going "to the right", and the order of the kept nodes is preserved. This takes O(N) time and keeps a set of the distinct keys. The removed nodes have their Next and Prev pointers cleared.
+
+DedupByKey returns the head of the chain. In a circular chain, which has no head, the walk stops before reaching n again and n is returned.
+
+Example:
+
+	type person struct {
+		name string
+		tags []string
+	}
+	// anchor: {"Alice", ...} --- {"Bob", ...} --- {"Alice", ...}
+	head := lnode.DedupByKey(anchor, func(p person) string { return p.name })
+	// New structure:
+	// {"Alice", ...} --- {"Bob", ...}
+	// ^head
+*/
+func DedupByKey[V any, K comparable](n *Node[V], key func(V) K) *Node[V] {
+	if n == nil {
+		return nil
+	}
+	seen := map[K]bool{key(n.Value): true}
+	node := n
+	for next := node.advance(n); next != nil; next = node.advance(n) {
+		k := key(next.Value)
+		if seen[k] {
+			next.detach()
+		} else {
+			seen[k] = true
+			node = next
+		}
+	}
+	return n.headOrSelf()
+}
+
+/*
+FilterMap returns a new chain holding the transformed values that fn decides to keep. For every value of the chain, starting at n and going "to the right", fn returns the transformed value and whether to keep it. This takes a single pass and doesn't build intermediate nodes, as a transformation followed by a filter would. When nothing is kept, nil is returned. Circular chains are handled like VisitByNext() does: every node is visited once.
+
+Example:
+
+	// anchor: "1" --- "x" --- "3"
+	numbers := lnode.FilterMap(anchor, func(s string) (int, bool) {
+		v, err := strconv.Atoi(s)
+		return v, err == nil
+	})
+	// Structure of the new chain:
+	// 1 --- 3
+	// ^numbers
+*/
+func FilterMap[V any, W any](n *Node[V], fn func(V) (W, bool)) *Node[W] {
+	var out builder[W]
+	n.EachValue(func(v V) {
+		if w, keep := fn(v); keep {
+			out.add(w)
+		}
+	})
+	return out.head
+}
+
+/*
+Weave distributes the values of the chain, starting at n and going "to the right", over buckets new chains in round-robin fashion: the first value goes to the first bucket, the second value to the second bucket, and so on, wrapping around. This is useful for sharding a sequence. Unlike SplitN(), which cuts a chain into consecutive parts, Weave interleaves, and it leaves the original chain intact. The returned slice has buckets elements; buckets that receive no values are nil. Circular chains are handled like VisitByNext() does: every node is visited once.
+
+Example:
+
+	// anchor: 0 --- 1 --- 2 --- 3 --- 4
+	shards := lnode.Weave(anchor, 2)
+	// Structures:
+	// 0 --- 2 --- 4     shards[0]
+	// 1 --- 3           shards[1]
+*/
+func Weave[V any](n *Node[V], buckets int) []*Node[V] {
+	if buckets <= 0 {
+		return []*Node[V]{}
+	}
+	builders := make([]builder[V], buckets)
+	i := 0
+	n.EachValue(func(v V) {
+		builders[i%buckets].add(v)
+		i++
+	})
+	out := make([]*Node[V], buckets)
+	for i, b := range builders {
+		out[i] = b.head
+	}
+	return out
+}
+
+/*
+ParallelMap returns a new chain holding fn applied to every value of the chain, starting at n and going "to the right", like Pluck() does, but with the calls to fn spread over workers goroutines. The values are first copied into a slice, so this trades memory for parallelism; it is worthwhile only when fn is expensive. The order of the result matches the order of the input. fn must be safe for concurrent calls. A workers value less than 1 is treated as 1. When n is nil, nil is returned. Circular chains are handled like VisitByNext() does: every node is visited once.
+
+Example:
+
+	// anchor: 1 --- 2 --- 3
+	squares := lnode.ParallelMap(anchor, 4, func(v int) int { return v * v })
+	// Structure of the new chain:
+	// 1 --- 4 --- 9
+	// ^squares
+*/
+func ParallelMap[V any, W any](n *Node[V], workers int, fn func(V) W) *Node[W] {
+	values := n.ToSlice()
+	results := make([]W, len(values))
+	workers = max(min(workers, len(values)), 1)
+
+	var wg sync.WaitGroup
+	for w := range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := w; i < len(values); i += workers {
+				results[i] = fn(values[i])
+			}
+		}()
+	}
+	wg.Wait()
+
+	head, _ := BuildChain(results)
+	return head
+}
+
+// Indexed is a value together with its position in a chain, as produced by Enumerate().
+type Indexed[V any] struct {
+	Index int // Position, counting from 0
+	Value V   // Value at that position
+}
+
+/*
+Enumerate returns a new chain that pairs every value of the chain, starting at n and going "to the right", with its position. This is handy when downstream code needs positions, but should still be handed a chain. Enumerate is a function rather than a method, since Go doesn't allow a method of Node[V] to return a Node[Indexed[V]]. When n is nil, nil is returned. Circular chains are handled like VisitByNext() does: every node is visited once, and the result is linear.
+
+Example:
+
+	// anchor: "a" --- "b" --- "c"
+	pairs := lnode.Enumerate(anchor)
+	// Structure of the new chain:
+	// {0 "a"} --- {1 "b"} --- {2 "c"}
+	// ^pairs
+*/
+func Enumerate[V any](n *Node[V]) *Node[Indexed[V]] {
+	var out builder[Indexed[V]]
+	i := 0
+	n.EachValue(func(v V) {
+		out.add(Indexed[V]{Index: i, Value: v})
+		i++
+	})
+	return out.head
+}
+
+/*
+MapNeighbors returns a new chain holding, for every node of the chain, starting at n and going "to the right", the value that fn computes from the node and its neighbors. fn receives the node's Prev, the node itself and its Next, so prev is nil at the head and next is nil at the tail. This suits finite differences and smoothing. The original chain is left untouched. When n is nil, nil is returned.
+
+Circular chains are handled like VisitByNext() does: every node is visited once, and the result is linear. Since every node of a circular chain has neighbors, prev and next are never nil there: the neighbors of the first and last visited nodes wrap around.
+
+Example:
+
+	// anchor: 1 --- 4 --- 9
+	diffs := anchor.MapNeighbors(func(prev, cur, next *lnode.Node[int]) int {
+		if prev == nil {
+			return 0
+		}
+		return cur.Value - prev.Value
+	})
+	// Structure of the new chain:
+	// 0 --- 3 --- 5
+	// ^diffs
+*/
+func (n *Node[V]) MapNeighbors(fn func(prev, cur, next *Node[V]) V) *Node[V] {
+	var out builder[V]
+	n.Each(func(node *Node[V]) {
+		out.add(fn(node.Prev, node, node.Next))
+	})
+	return out.head
+}
@@ -0,0 +1,331 @@
+package lnode
+
+import (
+	"slices"
+	"strconv"
+	"testing"
+)
+
+func TestTee(t *testing.T) {
+	nodes := chainOf(0, 1, 2)
+	clones := Tee(nodes[0], 3)
+	if len(clones) != 3 {
+		t.Fatalf("Tee(3): got %d clones, want 3", len(clones))
+	}
+	for i, clone := range clones {
+		clone.Value = 10 * (i + 1)
+	}
+	for i, clone := range clones {
+		want := []int{10 * (i + 1), 1, 2}
+		if got := valuesOf(clone); !slices.Equal(got, want) || !wellLinked(clone) || clone.Prev != nil {
+			t.Errorf("Tee: clone %d: got %v, want %v", i, got, want)
+		}
+	}
+	if got, want := valuesOf(nodes[0]), []int{0, 1, 2}; !slices.Equal(got, want) {
+		t.Errorf("Tee: source changed to %v, want %v", got, want)
+	}
+
+	if got := Tee(nodes[0], 0); len(got) != 0 {
+		t.Errorf("Tee(0): got %d clones, want 0", len(got))
+	}
+	if got := Tee[int](nil, 2); len(got) != 2 || got[0] != nil || got[1] != nil {
+		t.Errorf("Tee(nil, 2) = %v, want two nil chains", got)
+	}
+
+	closeLoop(nodes)
+	for i, clone := range Tee(nodes[1], 2) {
+		if got, want := valuesOf(clone), []int{1, 2, 0}; !slices.Equal(got, want) || !clone.Circular() {
+			t.Errorf("Tee of circular chain: clone %d: got %v (circular: %v), want circular %v", i, got, clone.Circular(), want)
+		}
+	}
+}
+
+func TestScan(t *testing.T) {
+	if got := Scan[int, int](nil, 0, func(acc, v int) int { return acc + v }); got != nil {
+		t.Errorf("Scan(nil) = %v, want nil", got)
+	}
+
+	nodes := chainOf(1, 2, 3, 4)
+	sums := Scan(nodes[0], 10, func(acc, v int) int { return acc + v })
+	if got, want := valuesOf(sums), []int{11, 13, 16, 20}; !slices.Equal(got, want) || !wellLinked(sums) {
+		t.Errorf("Scan running sum: got %v, want %v", got, want)
+	}
+
+	strs := Scan(nodes[0], "", func(acc string, v int) string { return acc + strconv.Itoa(v) })
+	if got, want := valuesOf(strs), []string{"1", "12", "123", "1234"}; !slices.Equal(got, want) {
+		t.Errorf("Scan concatenation: got %v, want %v", got, want)
+	}
+
+	closeLoop(nodes)
+	sums = Scan(nodes[2], 0, func(acc, v int) int { return acc + v })
+	if got, want := valuesOf(sums), []int{3, 7, 8, 10}; !slices.Equal(got, want) {
+		t.Errorf("Scan of circular chain: got %v, want %v", got, want)
+	}
+}
+
+func TestPluck(t *testing.T) {
+	type person struct {
+		name string
+		age  int
+	}
+	people := chainOf(person{"Alice", 30}, person{"Bob", 25}, person{"Carol", 35})
+
+	names := Pluck(people[0], func(p person) string { return p.name })
+	if got, want := valuesOf(names), []string{"Alice", "Bob", "Carol"}; !slices.Equal(got, want) || !wellLinked(names) {
+		t.Errorf("Pluck names: got %v, want %v", got, want)
+	}
+	ages := Pluck(people[1], func(p person) int { return p.age })
+	if got, want := valuesOf(ages), []int{25, 35}; !slices.Equal(got, want) {
+		t.Errorf("Pluck ages: got %v, want %v", got, want)
+	}
+	if got := Pluck(nil, func(p person) int { return p.age }); got != nil {
+		t.Errorf("Pluck(nil) = %v, want nil", got)
+	}
+}
+
+func TestInterleave(t *testing.T) {
+	for _, test := range []struct {
+		a, b []int
+		want []int
+	}{
+		{a: nil, b: nil, want: nil},
+		{a: []int{1, 2}, b: nil, want: []int{1, 2}},
+		{a: nil, b: []int{1, 2}, want: []int{1, 2}},
+		{a: []int{1, 2, 3}, b: []int{10, 20, 30}, want: []int{1, 10, 2, 20, 3, 30}},
+		{a: []int{1, 2, 3, 4}, b: []int{10, 20}, want: []int{1, 10, 2, 20, 3, 4}},
+		{a: []int{1}, b: []int{10, 20, 30}, want: []int{1, 10, 20, 30}},
+	} {
+		var a, b *Node[int]
+		if len(test.a) > 0 {
+			a = chainOf(test.a...)[0]
+		}
+		if len(test.b) > 0 {
+			b = chainOf(test.b...)[0]
+		}
+		got := Interleave(a, b)
+		if vals := valuesOf(got); !slices.Equal(vals, test.want) || !wellLinked(got) {
+			t.Errorf("Interleave(%v, %v) = %v, want %v", test.a, test.b, vals, test.want)
+		}
+		if a != nil && got == a || b != nil && got == b {
+			t.Errorf("Interleave(%v, %v) returned an input node, want a copy", test.a, test.b)
+		}
+	}
+
+	ring := chainOf(1, 2, 3)
+	closeLoop(ring)
+	got := Interleave(ring[1], chainOf(10)[0])
+	if vals, want := valuesOf(got), []int{2, 10, 3, 1}; !slices.Equal(vals, want) {
+		t.Errorf("Interleave with circular chain = %v, want %v", vals, want)
+	}
+}
+
+func TestDedupFunc(t *testing.T) {
+	type tagged struct {
+		tags []string
+	}
+	sameTags := func(a, b tagged) bool { return slices.Equal(a.tags, b.tags) }
+	x, y := tagged{[]string{"x"}}, tagged{[]string{"y"}}
+
+	for _, test := range []struct {
+		values []tagged
+		want   []tagged
+	}{
+		{values: []tagged{x}, want: []tagged{x}},
+		{values: []tagged{x, x, x}, want: []tagged{x}},
+		{values: []tagged{x, x, y, y, y, x}, want: []tagged{x, y, x}},
+		{values: []tagged{x, y, x}, want: []tagged{x, y, x}},
+	} {
+		nodes := chainOf(test.values...)
+		head := DedupFunc(nodes[0], sameTags)
+		if got := valuesOf(head); !slices.EqualFunc(got, test.want, sameTags) || !wellLinked(head) {
+			t.Errorf("DedupFunc(%v) = %v, want %v", test.values, got, test.want)
+		}
+	}
+
+	if got := DedupFunc(nil, sameTags); got != nil {
+		t.Errorf("DedupFunc(nil) = %v, want nil", got)
+	}
+
+	eq := func(a, b int) bool { return a == b }
+	nodes := chainOf(1, 1, 2, 2, 1)
+	closeLoop(nodes)
+	head := DedupFunc(nodes[0], eq)
+	if got, want := valuesOf(head), []int{1, 2, 1}; head != nodes[0] || !slices.Equal(got, want) || !head.Circular() {
+		t.Errorf("DedupFunc of circular chain = %v, want ring %v", got, want)
+	}
+}
+
+func TestCoalesce(t *testing.T) {
+	type interval struct{ from, to int }
+	overlaps := func(a, b interval) bool { return b.from <= a.to }
+	join := func(a, b interval) interval { return interval{a.from, max(a.to, b.to)} }
+	nodes := chainOf(interval{1, 3}, interval{2, 5}, interval{7, 8}, interval{8, 9}, interval{10, 11})
+	head := Coalesce(nodes[0], overlaps, join)
+	want := []interval{{1, 5}, {7, 9}, {10, 11}}
+	if got := valuesOf(head); head != nodes[0] || !slices.Equal(got, want) || !wellLinked(head) {
+		t.Errorf("Coalesce intervals: got %v, want %v", got, want)
+	}
+	if nodes[1].Next != nil || nodes[1].Prev != nil {
+		t.Errorf("Coalesce: absorbed node is still linked")
+	}
+
+	// Merging 2 and 3 into 5 makes it mergeable with the 5 before it.
+	sameOrSum := func(a, b int) bool { return a == b || a+b == 5 }
+	sum := func(a, b int) int { return a + b }
+	ints := chainOf(1, 5, 2, 3, 4)
+	merged := Coalesce(ints[0], sameOrSum, sum)
+	if got := valuesOf(merged); !slices.Equal(got, []int{1, 10, 4}) || !wellLinked(merged) {
+		t.Errorf("Coalesce with merge cascading backwards: got %v, want [1 10 4]", got)
+	}
+
+	ring := chainOf(1, 1, 2, 2)
+	closeLoop(ring)
+	eq := func(a, b int) bool { return a == b }
+	merged = Coalesce(ring[1], eq, sum)
+	if got := valuesOf(merged); merged != ring[1] || !slices.Equal(got, []int{1, 4, 1}) || merged.RingLen() != 3 {
+		t.Errorf("Coalesce of circular chain: got %v, want ring [1 4 1]", got)
+	}
+
+	if got := Coalesce(nil, eq, sum); got != nil {
+		t.Errorf("Coalesce of nil node = %v, want nil", got)
+	}
+}
+
+func TestDedupByKey(t *testing.T) {
+	type item struct {
+		key  string
+		tags []string
+	}
+	byKey := func(i item) string { return i.key }
+	nodes := chainOf(item{key: "a"}, item{key: "b"}, item{key: "a", tags: []string{"x"}}, item{key: "c"}, item{key: "b"})
+	head := DedupByKey(nodes[1], byKey)
+	var keys []string
+	head.EachValue(func(i item) { keys = append(keys, i.key) })
+	if head != nodes[0] || !slices.Equal(keys, []string{"a", "b", "a", "c"}) || !wellLinked(head) {
+		t.Errorf("DedupByKey from second node: got keys %v, want [a b a c]", keys)
+	}
+	if nodes[4].Next != nil || nodes[4].Prev != nil {
+		t.Errorf("DedupByKey: removed node is still linked")
+	}
+
+	ints := chainOf(1, 2, 3, 4, 5, 6)
+	closeLoop(ints)
+	ring := DedupByKey(ints[0], func(v int) int { return v % 3 })
+	if got := valuesOf(ring); ring != ints[0] || !slices.Equal(got, []int{1, 2, 3}) || ring.RingLen() != 3 {
+		t.Errorf("DedupByKey of circular chain: got %v, want ring [1 2 3]", got)
+	}
+
+	if got := DedupByKey(nil, byKey); got != nil {
+		t.Errorf("DedupByKey of nil node = %v, want nil", got)
+	}
+}
+
+func TestFilterMap(t *testing.T) {
+	atoi := func(s string) (int, bool) {
+		v, err := strconv.Atoi(s)
+		return v, err == nil
+	}
+	nodes := chainOf("1", "x", "3", "", "5")
+	got := FilterMap(nodes[0], atoi)
+	if vals, want := valuesOf(got), []int{1, 3, 5}; !slices.Equal(vals, want) || !wellLinked(got) {
+		t.Errorf("FilterMap = %v, want %v", vals, want)
+	}
+	if got := FilterMap(chainOf("x", "y")[0], atoi); got != nil {
+		t.Errorf("FilterMap without kept values = %v, want nil", valuesOf(got))
+	}
+	if got := FilterMap(nil, atoi); got != nil {
+		t.Errorf("FilterMap(nil) = %v, want nil", got)
+	}
+}
+
+func TestWeave(t *testing.T) {
+	nodes := chainOf(0, 1, 2, 3, 4)
+	for _, test := range []struct {
+		buckets int
+		want    [][]int
+	}{
+		{buckets: 1, want: [][]int{{0, 1, 2, 3, 4}}},
+		{buckets: 2, want: [][]int{{0, 2, 4}, {1, 3}}},
+		{buckets: 3, want: [][]int{{0, 3}, {1, 4}, {2}}},
+		{buckets: 7, want: [][]int{{0}, {1}, {2}, {3}, {4}, nil, nil}},
+		{buckets: 0, want: [][]int{}},
+	} {
+		shards := Weave(nodes[0], test.buckets)
+		got := [][]int{}
+		for _, shard := range shards {
+			got = append(got, valuesOf(shard))
+		}
+		if !slices.EqualFunc(got, test.want, slices.Equal) {
+			t.Errorf("Weave(%d) = %v, want %v", test.buckets, got, test.want)
+		}
+	}
+	if got, want := valuesOf(nodes[0]), []int{0, 1, 2, 3, 4}; !slices.Equal(got, want) {
+		t.Errorf("Weave changed the original to %v, want %v", got, want)
+	}
+}
+
+func TestParallelMap(t *testing.T) {
+	values := make([]int, 100)
+	want := make([]string, 100)
+	for i := range values {
+		values[i] = i
+		want[i] = strconv.Itoa(i * i)
+	}
+	head, _ := BuildChain(values)
+	for _, workers := range []int{0, 1, 3, 200} {
+		got := ParallelMap(head, workers, func(v int) string { return strconv.Itoa(v * v) })
+		if vals := valuesOf(got); !slices.Equal(vals, want) || !wellLinked(got) {
+			t.Errorf("ParallelMap: %d workers: got %v, want %v", workers, vals, want)
+		}
+	}
+	if got := ParallelMap(nil, 2, strconv.Itoa); got != nil {
+		t.Errorf("ParallelMap: nil chain: got %v, want nil", got)
+	}
+}
+
+func TestEnumerate(t *testing.T) {
+	var empty *Node[string]
+	if got := Enumerate(empty); got != nil {
+		t.Errorf("Enumerate of nil node = %v, want nil", got)
+	}
+	nodes := chainOf("a", "b", "c")
+	closeLoop(nodes)
+	got := Enumerate(nodes[1])
+	want := []Indexed[string]{{0, "b"}, {1, "c"}, {2, "a"}}
+	if vals := valuesOf(got); !slices.Equal(vals, want) || !wellLinked(got) || got.Tail() == nil {
+		t.Errorf("Enumerate: got %v, want linear %v", vals, want)
+	}
+}
+
+func TestMapNeighbors(t *testing.T) {
+	// Sum of the node and its neighbors, counting missing ones as 0.
+	sum := func(prev, cur, next *Node[int]) int {
+		total := cur.Value
+		if prev != nil {
+			total += prev.Value
+		}
+		if next != nil {
+			total += next.Value
+		}
+		return total
+	}
+	nodes := chainOf(1, 2, 3, 4)
+	got := nodes[1].MapNeighbors(sum)
+	if vals := valuesOf(got); !slices.Equal(vals, []int{6, 9, 7}) || !wellLinked(got) {
+		t.Errorf("MapNeighbors: got %v, want [6 9 7]", vals)
+	}
+	if vals := valuesOf(nodes[0]); !slices.Equal(vals, []int{1, 2, 3, 4}) {
+		t.Errorf("MapNeighbors: original changed to %v", vals)
+	}
+
+	closeLoop(nodes)
+	got = nodes[0].MapNeighbors(sum)
+	if vals := valuesOf(got); !slices.Equal(vals, []int{7, 6, 9, 8}) || got.Tail() == nil {
+		t.Errorf("MapNeighbors of circular chain: got %v, want linear [7 6 9 8]", vals)
+	}
+
+	var empty *Node[int]
+	if got := empty.MapNeighbors(sum); got != nil {
+		t.Errorf("MapNeighbors of nil node = %v, want nil", got)
+	}
+}
@@ -0,0 +1,42 @@
+package lnode
+
+import "iter"
+
+// ReverseView is a read-only view of a chain that walks it in reverse: its "next" direction follows the Prev pointers of the underlying nodes. It's obtained via Node.ReversedView(). No pointers are changed, so creating a view is O(1).
+type ReverseView[V any] struct {
+	start *Node[V]
+}
+
+/*
+ReversedView returns a view of the chain in which the node is the first one, and the nodes "to the left" follow. This is cheaper than reversing the chain in place when it only needs to be read in reverse. Example:
+
+	anchor := lnode.New[int](0)
+	anchor.Append(New[int](1))
+	anchor.Next.Append(New[int](2))
+	// Structure:
+	// 0 --- 1 --- 2
+	// ^anchor
+
+	for node := range anchor.Tail().ReversedView().All() {
+		fmt.Println(node.Value)
+	}
+	// Output:
+	// 2
+	// 1
+	// 0
+*/
+func (n *Node[V]) ReversedView() ReverseView[V] {
+	return ReverseView[V]{start: n}
+}
+
+// VisitByNext invokes a visitor function (callback) on the view's first node, and then on the nodes that follow in the view, i.e., the ones "to the left" in the underlying chain. It behaves like Node.VisitByPrev(): when the callback returns false no further nodes are processed, and circular chains stop before revisiting a node.
+func (rv ReverseView[V]) VisitByNext(fn func(node *Node[V]) bool) {
+	rv.start.VisitByPrev(fn)
+}
+
+// All returns an iterator over the nodes in the view, in the same order as VisitByNext() visits them.
+func (rv ReverseView[V]) All() iter.Seq[*Node[V]] {
+	return func(yield func(*Node[V]) bool) {
+		rv.VisitByNext(yield)
+	}
+}
@@ -0,0 +1,48 @@
+package lnode
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestReversedView(t *testing.T) {
+	nodes := chainOf(0, 1, 2, 3)
+	view := nodes[3].ReversedView()
+
+	var got []int
+	view.VisitByNext(func(node *Node[int]) bool {
+		got = append(got, node.Value)
+		return true
+	})
+	if want := []int{3, 2, 1, 0}; !slices.Equal(got, want) {
+		t.Errorf("ReversedView().VisitByNext: got %v, want %v", got, want)
+	}
+
+	got = nil
+	for node := range view.All() {
+		if node.Value == 1 {
+			break
+		}
+		got = append(got, node.Value)
+	}
+	if want := []int{3, 2}; !slices.Equal(got, want) {
+		t.Errorf("ReversedView().All() with break: got %v, want %v", got, want)
+	}
+
+	// The underlying chain is not changed.
+	if got, want := valuesOf(nodes[0]), []int{0, 1, 2, 3}; !slices.Equal(got, want) || !wellLinked(nodes[0]) {
+		t.Errorf("ReversedView changed the chain to %v, want %v", got, want)
+	}
+
+	closeLoop(nodes)
+	got = slices.Collect(func(yield func(int) bool) {
+		for node := range nodes[1].ReversedView().All() {
+			if !yield(node.Value) {
+				return
+			}
+		}
+	})
+	if want := []int{1, 0, 3, 2}; !slices.Equal(got, want) {
+		t.Errorf("ReversedView().All() on circular chain: got %v, want %v", got, want)
+	}
+}